@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 
 	_ "github.com/jackc/pgx/v4"
@@ -13,6 +14,7 @@ import (
 
 	cfg "github.com/ozoncp/ocp-offer-api/internal/config"
 	"github.com/ozoncp/ocp-offer-api/internal/database"
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
 	"github.com/ozoncp/ocp-offer-api/internal/server"
 	"github.com/ozoncp/ocp-offer-api/internal/tracer"
 	"github.com/pressly/goose/v3"
@@ -42,19 +44,22 @@ func main() {
 		cfg.Database.SSLMode,
 	)
 
-	db := database.NewPostgres(dsn, cfg.Database.Driver)
+	// The in-memory repository doesn't need the database
+	var db *sqlx.DB
+	if cfg.Database.Driver != repo.MemoryDriver {
+		db = database.NewPostgres(dsn, cfg.Database.Driver)
+		defer db.Close()
 
-	if *migration != "" {
-		migrate(db.DB, *migration)
+		if *migration != "" {
+			migrate(db.DB, *migration)
+		}
 	}
 
 	tracer.InitTracing("ocp_offer_api")
 
-	if err := server.NewGrpcServer(db, batchSize).Start(); err != nil {
+	if err := server.NewGrpcServer(db, dsn, batchSize).Start(); err != nil {
 		log.Fatal().Err(err).Msg("Failed creating gRPC server")
 	}
-
-	db.Close()
 }
 
 func migrate(db *sql.DB, command string) {
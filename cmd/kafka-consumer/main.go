@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
 	_ "github.com/jackc/pgx/v4"
 	_ "github.com/jackc/pgx/v4/stdlib"
@@ -25,9 +27,23 @@ const (
 	batchSize = 2
 )
 
+// Usage:
+//
+//	kafka-consumer                  - handles the commands of kafka.topic
+//	kafka-consumer redrive [-idle]  - moves the messages of kafka.deadLetterTopic back to kafka.topic
 func main() {
 	tracer.InitTracing("ocp_offer_api-kafka_consumer")
 
+	if len(os.Args) > 1 && os.Args[1] == "redrive" {
+		redrive(os.Args[2:])
+
+		return
+	}
+
+	consume()
+}
+
+func newConfig() *sarama.Config {
 	version, err := sarama.ParseKafkaVersion("2.8.0")
 	if err != nil {
 		log.Fatal().Err(err).Msg("Error parsing Kafka version")
@@ -38,6 +54,11 @@ func main() {
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
 	config.Version = version
 
+	return config
+}
+
+func consume() {
+	config := newConfig()
 	topics := []string{cfg.Kafka.Topic}
 
 	dsn := fmt.Sprintf("host=%v port=%v user=%v password=%v dbname=%v sslmode=%v",
@@ -49,12 +70,28 @@ func main() {
 		cfg.Database.SSLMode,
 	)
 
-	db := database.NewPostgres(dsn, cfg.Database.Driver)
-	r := repo.NewRepo(db, batchSize)
+	var r repo.IRepository
+	if cfg.Database.Driver == repo.MemoryDriver {
+		log.Warn().Msg("The offers are kept in memory and are lost on restart")
+		r = repo.NewMemoryRepo(batchSize, nil)
+	} else {
+		r = repo.NewRepo(database.NewPostgres(dsn, cfg.Database.Driver), batchSize)
+	}
 
-	consumer, ok := service.NewConsumer(r, topics, config).(*service.Consumer)
+	deadLetter, err := service.NewDeadLetterProducer(cfg.Kafka.Brokers, cfg.Kafka.DeadLetterTopic)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error creating dead-letter producer")
+	}
+
+	retry := service.RetryPolicy{
+		MaxAttempts:    cfg.Consumer.MaxAttempts,
+		InitialBackoff: time.Duration(cfg.Consumer.InitialBackoff) * time.Millisecond,
+		MaxBackoff:     time.Duration(cfg.Consumer.MaxBackoff) * time.Millisecond,
+	}
+
+	consumer, ok := service.NewConsumer(r, topics, config, retry, deadLetter).(*service.Consumer)
 	if !ok {
-		log.Fatal().Err(err).Msg("Error creating consumer")
+		log.Fatal().Msg("Error creating consumer")
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -99,4 +136,81 @@ func main() {
 	if err = client.Close(); err != nil {
 		log.Error().Msgf("Error closing client: %v", err)
 	}
+
+	if err = deadLetter.Close(); err != nil {
+		log.Error().Msgf("Error closing dead-letter producer: %v", err)
+	}
+}
+
+// redrive moves the dead-letter messages to the commands topic until no message comes for the idle time.
+// The messages are read by their own consumer group, so a message is moved once.
+func redrive(args []string) {
+	flags := flag.NewFlagSet("redrive", flag.ExitOnError)
+	idle := flags.Duration("idle", 10*time.Second, "Stop after no message comes for this long")
+	_ = flags.Parse(args)
+
+	config := newConfig()
+	topics := []string{cfg.Kafka.DeadLetterTopic}
+
+	redriver, err := service.NewRedriver(cfg.Kafka.Brokers, cfg.Kafka.Topic)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error creating redrive producer")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client, err := sarama.NewConsumerGroup(cfg.Kafka.Brokers, cfg.Kafka.GroupID+"-redrive", config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error creating consumer group client")
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			if err := client.Consume(ctx, topics, redriver); err != nil {
+				log.Error().Err(err).Msg("Error from redrive consumer")
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			redriver.Ready = make(chan bool)
+		}
+	}()
+
+	<-redriver.Ready
+	log.Info().Msgf("Redriving %s to %s", cfg.Kafka.DeadLetterTopic, cfg.Kafka.Topic)
+
+	sigterm := make(chan os.Signal, 1)
+	signal.Notify(sigterm, syscall.SIGINT, syscall.SIGTERM)
+
+	timer := time.NewTimer(*idle)
+
+	for waiting := true; waiting; {
+		select {
+		case <-redriver.Moved():
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(*idle)
+		case <-timer.C:
+			log.Info().Msg("terminating: no more dead-letter messages")
+			waiting = false
+		case <-sigterm:
+			log.Info().Msg("terminating: via signal")
+			waiting = false
+		}
+	}
+
+	cancel()
+
+	wg.Wait()
+
+	if err = client.Close(); err != nil {
+		log.Error().Msgf("Error closing client: %v", err)
+	}
+
+	if err = redriver.Close(); err != nil {
+		log.Error().Msgf("Error closing redrive producer: %v", err)
+	}
 }
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	_ "github.com/jackc/pgx/v4"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	_ "github.com/lib/pq"
+
+	"github.com/ozoncp/ocp-offer-api/internal/api"
+	cfg "github.com/ozoncp/ocp-offer-api/internal/config"
+	"github.com/ozoncp/ocp-offer-api/internal/database"
+	"github.com/ozoncp/ocp-offer-api/internal/importer"
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+)
+
+const (
+	batchSize = 500
+)
+
+func main() {
+	os.Exit(run())
+}
+
+// run imports the file and returns the exit code, 1 if the import or any row failed.
+// The deferred closing of the file and the database is done before the exit.
+func run() int {
+	file := flag.String("file", "-", "CSV or JSONL file with offers, '-' reads the standard input")
+	formatName := flag.String("format", "", "Format of the file: csv or jsonl, by default it is taken from the file extension")
+	dryRun := flag.Bool("dry-run", false, "Only validate the rows, nothing is created")
+	actor := flag.String("actor", "offer-import", "Author of the changes in the offer history")
+	flag.Parse()
+
+	if *formatName == "" {
+		*formatName = strings.TrimPrefix(filepath.Ext(*file), ".")
+	}
+
+	format, err := importer.ParseFormat(*formatName)
+	if err != nil {
+		log.Error().Err(err).Msg("Set the format with the 'format' flag")
+
+		return 1
+	}
+
+	var input io.Reader = os.Stdin
+
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to open the file")
+
+			return 1
+		}
+		defer f.Close()
+
+		input = f
+	}
+
+	dsn := fmt.Sprintf("host=%v port=%v user=%v password=%v dbname=%v sslmode=%v",
+		cfg.Database.Host,
+		cfg.Database.Port,
+		cfg.Database.User,
+		cfg.Database.Password,
+		cfg.Database.Name,
+		cfg.Database.SSLMode,
+	)
+
+	var r repo.IRepository
+	if cfg.Database.Driver == repo.MemoryDriver {
+		// The created offers would be lost on exit, the memory driver only validates the file
+		if !*dryRun {
+			log.Error().Msg("The memory driver is allowed only with the 'dry-run' flag")
+
+			return 1
+		}
+
+		r = repo.NewMemoryRepo(batchSize, nil)
+	} else {
+		db := database.NewPostgres(dsn, cfg.Database.Driver)
+		defer db.Close()
+
+		r = repo.NewRepo(db, batchSize)
+	}
+
+	ctx := models.ContextWithActor(context.Background(), *actor)
+
+	// The offers are created by the service like in the gRPC server, only without the interceptors
+	offerAPI := api.NewOfferAPI(r, nil, nil)
+
+	report, err := importer.NewImporter(offerAPI.MultiCreateOfferV1).Import(ctx, input, format, *dryRun)
+	if err != nil {
+		log.Error().Err(err).Msg("Import failed")
+
+		return 1
+	}
+
+	// The report goes to the standard output, the summary to the log
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		log.Error().Err(err).Msg("Failed to write the report")
+	}
+
+	log.Info().
+		Bool("dryRun", report.DryRun).
+		Int("created", report.Created).
+		Int("skipped", report.Skipped).
+		Int("failed", report.Failed).
+		Msg("Import finished")
+
+	if report.Failed > 0 {
+		return 1
+	}
+
+	return 0
+}
@@ -2,19 +2,33 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ozoncp/ocp-offer-api/internal/models"
 	"github.com/ozoncp/ocp-offer-api/internal/repo"
 	"github.com/ozoncp/ocp-offer-api/internal/service"
+	utils "github.com/ozoncp/ocp-offer-api/internal/utils/models"
+	"github.com/ozoncp/ocp-offer-api/internal/utils/uuid"
+	"github.com/ozoncp/ocp-offer-api/internal/watch"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
 )
 
+// IfMatchMetadataKey - metadata key with the expected offer version.
+// The gateway fills it from the `If-Match` HTTP header.
+const IfMatchMetadataKey = "if-match"
+
 var (
 	totalSuccessCreated = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "ocp_offer_api_success_created_total",
@@ -28,16 +42,32 @@ var (
 		Name: "ocp_offer_api_success_deleted_total",
 		Help: "Total number of requests for offers successfully deleted",
 	})
+	totalSuccessRestored = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ocp_offer_api_success_restored_total",
+		Help: "Total number of requests for offers successfully restored",
+	})
+	totalStatusChanged = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocp_offer_api_status_changed_total",
+		Help: "Total number of offers moved to a new lifecycle status",
+	}, []string{"status"})
+)
+
+const (
+	// watchBatchSize - number of changes read from the history at once.
+	watchBatchSize = 100
+	// watchPollInterval - the history is read at least this often, in case a notification is lost.
+	watchPollInterval = 30 * time.Second
 )
 
 type offerAPI struct {
 	pb.UnimplementedOcpOfferApiServiceServer
 	repo     repo.IRepository
 	producer service.IProducer
+	notifier watch.Notifier
 }
 
-func NewOfferAPI(r repo.IRepository, p service.IProducer) pb.OcpOfferApiServiceServer {
-	return &offerAPI{repo: r, producer: p}
+func NewOfferAPI(r repo.IRepository, p service.IProducer, n watch.Notifier) pb.OcpOfferApiServiceServer {
+	return &offerAPI{repo: r, producer: p, notifier: n}
 }
 
 func (o *offerAPI) CreateOfferV1(ctx context.Context, req *pb.CreateOfferV1Request) (*pb.CreateOfferV1Response, error) {
@@ -48,25 +78,29 @@ func (o *offerAPI) CreateOfferV1(ctx context.Context, req *pb.CreateOfferV1Reque
 	}
 
 	offer := models.Offer{
-		UserID: req.UserId,
-		Grade:  req.Grade,
-		TeamID: req.TeamId,
+		UserID:    req.UserId,
+		Grade:     req.Grade,
+		TeamID:    req.TeamId,
+		ExpiresAt: timestampToTime(req.ExpiresAt),
 	}
 
-	offerID, err := o.repo.CreateOffer(ctx, offer)
+	result, err := o.repo.CreateOffer(ctx, offer, models.ConflictPolicy(req.ConflictPolicy))
 
 	if err != nil {
 		log.Error().Err(err).Msg("CreateOfferV1 -- failed")
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repoError(err)
 	}
 
-	totalSuccessCreated.Inc()
+	if result.Action == models.CreateActionInserted {
+		totalSuccessCreated.Inc()
+	}
 
 	log.Debug().Msg("CreateOfferV1 - success")
 
 	return &pb.CreateOfferV1Response{
-		Id: offerID,
+		Id:     result.ID,
+		Result: pb.CreateOfferResult(result.Action),
 	}, nil
 }
 
@@ -83,24 +117,44 @@ func (o *offerAPI) MultiCreateOfferV1(ctx context.Context, req *pb.MultiCreateOf
 
 	for i, offer := range req.Offers {
 		offers[i] = models.Offer{
-			UserID: offer.UserId,
-			TeamID: offer.TeamId,
-			Grade:  offer.Grade,
+			UserID:    offer.UserId,
+			TeamID:    offer.TeamId,
+			Grade:     offer.Grade,
+			ExpiresAt: timestampToTime(offer.ExpiresAt),
 		}
 	}
 
-	count, err := o.repo.MultiCreateOffer(ctx, offers)
+	results, err := o.repo.MultiCreateOffer(ctx, offers, models.ConflictPolicy(req.ConflictPolicy), req.BestEffort)
 	if err != nil {
 		log.Error().Err(err).Msg("MultiCreateOfferV1 -- failed")
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repoError(err)
 	}
 
-	log.Debug().Msg("MultiCreateOfferV1 - success")
+	res := &pb.MultiCreateOfferV1Response{
+		Count:   models.CountCreated(results),
+		Ids:     make([]uint64, len(results)),
+		Errors:  make([]*pb.OfferError, 0),
+		Results: make([]pb.CreateOfferResult, len(results)),
+	}
 
-	return &pb.MultiCreateOfferV1Response{
-		Count: count,
-	}, nil
+	for i, result := range results {
+		res.Ids[i] = result.ID
+		res.Results[i] = pb.CreateOfferResult(result.Action)
+
+		if result.Err != nil {
+			st := status.Convert(repoError(result.Err))
+			res.Errors = append(res.Errors, &pb.OfferError{
+				Index:   uint32(i),
+				Code:    st.Code().String(),
+				Message: st.Message(),
+			})
+		}
+	}
+
+	log.Debug().Int("failed", len(res.Errors)).Msg("MultiCreateOfferV1 - success")
+
+	return res, nil
 }
 
 // ----------------------------------------------------------------
@@ -116,18 +170,292 @@ func (o *offerAPI) DescribeOfferV1(ctx context.Context, req *pb.DescribeOfferV1R
 	if err != nil {
 		log.Error().Err(err).Msg("DescribeOfferV1 -- failed")
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repoError(err)
 	}
 
 	log.Debug().Msg("DescribeOfferV1 - success")
 
 	return &pb.DescribeOfferV1Response{
-		Offer: &pb.Offer{
-			Id:     offer.ID,
-			UserId: offer.UserID,
-			Grade:  offer.Grade,
-			TeamId: offer.TeamID,
+		Offer: offerToPb(offer),
+	}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) ExportOffersV1(req *pb.ExportOffersV1Request, stream pb.OcpOfferApiService_ExportOffersV1Server) error {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("ExportOffersV1 - invalid argument")
+
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := filterFromPb(req.Filter)
+
+	if err := filter.Validate(); err != nil {
+		log.Error().Err(err).Msg("ExportOffersV1 - invalid argument")
+
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var count uint64
+
+	// Send blocks while the client doesn't read, which pauses the reading from the database
+	err := o.repo.ExportOffers(stream.Context(), filter, func(offer *models.Offer) error {
+		if err := stream.Send(&pb.ExportOffersV1Response{Offer: offerToPb(offer)}); err != nil {
+			return err
+		}
+
+		count++
+
+		return nil
+	})
+
+	if err != nil {
+		log.Error().Err(err).Uint64("count", count).Msg("ExportOffersV1 -- failed")
+
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		return repoError(err)
+	}
+
+	log.Debug().Uint64("count", count).Msg("ExportOffersV1 - success")
+
+	return nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) BatchGetOffersV1(ctx context.Context, req *pb.BatchGetOffersV1Request) (*pb.BatchGetOffersV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("BatchGetOffersV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	found, err := o.repo.BatchGetOffers(ctx, req.Ids)
+	if err != nil {
+		log.Error().Err(err).Msg("BatchGetOffersV1 -- failed")
+
+		return nil, repoError(err)
+	}
+
+	byID := make(map[uint64]*models.Offer, len(found))
+
+	for i := range found {
+		byID[found[i].ID] = &found[i]
+	}
+
+	offers := make([]*pb.Offer, 0, len(found))
+	notFoundIds := make([]uint64, 0)
+
+	for _, id := range req.Ids {
+		if offer, ok := byID[id]; ok {
+			offers = append(offers, offerToPb(offer))
+		} else {
+			notFoundIds = append(notFoundIds, id)
+		}
+	}
+
+	log.Debug().Msg("BatchGetOffersV1 - success")
+
+	return &pb.BatchGetOffersV1Response{
+		Offers:      offers,
+		NotFoundIds: notFoundIds,
+	}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) WatchOffersV1(req *pb.WatchOffersV1Request, stream pb.OcpOfferApiService_WatchOffersV1Server) error {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("WatchOffersV1 - invalid argument")
+
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := stream.Context()
+
+	// Subscribed before the start position is read, so no change in between is missed
+	wake, unsubscribe := o.notifier.Subscribe()
+	defer unsubscribe()
+
+	var (
+		after uint64
+		err   error
+	)
+
+	if req.ResumeToken != "" {
+		after, err = models.DecodeResumeToken(req.ResumeToken)
+		if err != nil {
+			log.Error().Err(err).Msg("WatchOffersV1 - invalid argument")
+
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else if after, err = o.repo.LastOfferEventPosition(ctx); err != nil {
+		log.Error().Err(err).Msg("WatchOffersV1 -- failed")
+
+		return repoError(err)
+	}
+
+	// Removed offers are included to stream their removal
+	filter := models.OfferFilter{
+		UserIDs:        req.UserIds,
+		TeamIDs:        req.TeamIds,
+		IncludeDeleted: true,
+	}
+
+	// One timer for the stream, a time.After per wait would stay alive until it fires
+	poll := time.NewTimer(watchPollInterval)
+	defer poll.Stop()
+
+	for {
+		events, err := o.repo.ListOfferEvents(ctx, after, filter, watchBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+
+			log.Error().Err(err).Msg("WatchOffersV1 -- failed")
+
+			return repoError(err)
+		}
+
+		for i := range events {
+			if err := stream.Send(&pb.WatchOffersV1Response{
+				ResumeToken: models.EncodeResumeToken(events[i].Position),
+				OfferId:     events[i].OfferID,
+				Revision:    revisionToPb(&events[i].OfferRevision),
+				Offer:       offerToPb(&events[i].Offer),
+			}); err != nil {
+				return err
+			}
+
+			after = events[i].Position
+		}
+
+		// A full batch means there may be more changes already
+		if len(events) == watchBatchSize {
+			continue
+		}
+
+		if !poll.Stop() {
+			select {
+			case <-poll.C:
+			default:
+			}
+		}
+		poll.Reset(watchPollInterval)
+
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("WatchOffersV1 - finished")
+
+			return status.FromContextError(ctx.Err()).Err()
+		case <-wake:
+		case <-poll.C:
+		}
+	}
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) GetOfferStatsV1(ctx context.Context, req *pb.GetOfferStatsV1Request) (*pb.GetOfferStatsV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("GetOfferStatsV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := models.OfferStatsFilter{
+		OfferFilter: models.OfferFilter{
+			IncludeDeleted: req.IncludeDeleted,
 		},
+		CreatedFrom: timestampToTime(req.CreatedFrom),
+		CreatedTo:   timestampToTime(req.CreatedTo),
+	}
+
+	if req.TeamId != 0 {
+		filter.TeamIDs = []uint64{req.TeamId}
+	}
+
+	if err := filter.Validate(); err != nil {
+		log.Error().Err(err).Msg("GetOfferStatsV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	groupBy := make([]models.OfferStatsDimension, len(req.GroupBy))
+
+	for i, dimension := range req.GroupBy {
+		groupBy[i] = models.OfferStatsDimension(dimension)
+	}
+
+	stats, err := o.repo.GetOfferStats(ctx, groupBy, filter)
+	if err != nil {
+		log.Error().Err(err).Msg("GetOfferStatsV1 -- failed")
+
+		return nil, repoError(err)
+	}
+
+	var total uint64
+
+	groups := make([]*pb.OfferStatsGroup, len(stats))
+
+	for i, group := range stats {
+		groups[i] = &pb.OfferStatsGroup{
+			TeamId: group.TeamID,
+			Grade:  group.Grade,
+			Status: pb.OfferStatus(group.Status),
+			Count:  group.Count,
+		}
+		total += group.Count
+	}
+
+	log.Debug().Msg("GetOfferStatsV1 - success")
+
+	return &pb.GetOfferStatsV1Response{
+		Groups: groups,
+		Total:  total,
+	}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) GetOfferHistoryV1(ctx context.Context, req *pb.GetOfferHistoryV1Request) (*pb.GetOfferHistoryV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("GetOfferHistoryV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	history, err := o.repo.GetOfferHistory(ctx, req.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("GetOfferHistoryV1 -- failed")
+
+		return nil, repoError(err)
+	}
+
+	// An offer created before the history was kept has no revisions
+	if len(history) == 0 {
+		if _, err := o.repo.DescribeOffer(ctx, req.Id); err != nil {
+			log.Error().Err(err).Msg("GetOfferHistoryV1 -- failed")
+
+			return nil, repoError(err)
+		}
+	}
+
+	revisions := make([]*pb.OfferRevision, len(history))
+
+	for i := range history {
+		revisions[i] = revisionToPb(&history[i])
+	}
+
+	log.Debug().Msg("GetOfferHistoryV1 - success")
+
+	return &pb.GetOfferHistoryV1Response{
+		Revisions: revisions,
 	}, nil
 }
 
@@ -140,25 +468,47 @@ func (o *offerAPI) ListOfferV1(ctx context.Context, req *pb.ListOfferV1Request)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	repoOffers, pagInfo, err := o.repo.ListOffer(ctx, models.PaginationInput{
-		Take: req.Pagination.Take,
-		Skip: req.Pagination.Skip,
-	})
+	filter := filterFromPb(req.Filter)
+
+	if err := filter.Validate(); err != nil {
+		log.Error().Err(err).Msg("ListOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pagination, err := paginationFromPb(req.Pagination)
+	if err != nil {
+		log.Error().Err(err).Msg("ListOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	order, err := models.ParseOfferOrder(req.OrderBy)
+	if err != nil {
+		log.Error().Err(err).Msg("ListOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if pagination.PageToken != nil {
+		if err := pagination.PageToken.Check(order); err != nil {
+			log.Error().Err(err).Msg("ListOfferV1 - invalid argument")
+
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	repoOffers, pagInfo, err := o.repo.ListOffer(ctx, pagination, filter, order)
 	if err != nil {
 		log.Error().Err(err).Msg("ListOfferV1 -- failed")
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repoError(err)
 	}
 
 	offers := make([]*pb.Offer, len(repoOffers))
 
-	for i, val := range repoOffers {
-		offers[i] = &pb.Offer{
-			Id:     val.ID,
-			UserId: val.UserID,
-			Grade:  val.Grade,
-			TeamId: val.TeamID,
-		}
+	for i := range repoOffers {
+		offers[i] = offerToPb(&repoOffers[i])
 	}
 
 	log.Debug().Msg("ListOfferV1 - success")
@@ -171,6 +521,7 @@ func (o *offerAPI) ListOfferV1(ctx context.Context, req *pb.ListOfferV1Request)
 			PerPage:         pagInfo.PerPage,
 			HasNextPage:     pagInfo.HasNextPage,
 			HasPreviousPage: pagInfo.HasPreviousPage,
+			NextPageToken:   nextPageToken(pagInfo),
 		},
 		Offers: offers,
 	}, nil
@@ -185,17 +536,26 @@ func (o *offerAPI) UpdateOfferV1(ctx context.Context, req *pb.UpdateOfferV1Reque
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		log.Error().Err(err).Msg("UpdateOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	data := models.Offer{
-		ID:     req.Id,
-		UserID: req.UserId,
-		Grade:  req.Grade,
-		TeamID: req.TeamId,
+		ID:        req.Id,
+		UserID:    req.UserId,
+		Grade:     req.Grade,
+		TeamID:    req.TeamId,
+		ExpiresAt: timestampToTime(req.ExpiresAt),
+		Version:   version,
 	}
 
 	if err := o.repo.UpdateOffer(ctx, data); err != nil {
 		log.Error().Err(err).Msg("UpdateOfferV1 -- failed")
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repoError(err)
 	}
 
 	totalSuccessUpdated.Inc()
@@ -207,6 +567,155 @@ func (o *offerAPI) UpdateOfferV1(ctx context.Context, req *pb.UpdateOfferV1Reque
 
 // ----------------------------------------------------------------
 
+func (o *offerAPI) PatchOfferV1(ctx context.Context, req *pb.PatchOfferV1Request) (*pb.PatchOfferV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("PatchOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+
+	if err := validatePatch(req.Offer, paths); err != nil {
+		log.Error().Err(err).Msg("PatchOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		log.Error().Err(err).Msg("PatchOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	data := models.Offer{
+		ID:        req.Id,
+		UserID:    req.Offer.UserId,
+		Grade:     req.Offer.Grade,
+		TeamID:    req.Offer.TeamId,
+		ExpiresAt: timestampToTime(req.Offer.ExpiresAt),
+		Version:   version,
+	}
+
+	if err := o.repo.PatchOffer(ctx, data, paths); err != nil {
+		log.Error().Err(err).Msg("PatchOfferV1 -- failed")
+
+		return nil, repoError(err)
+	}
+
+	totalSuccessUpdated.Inc()
+
+	log.Debug().Msg("PatchOfferV1 - success")
+
+	return &pb.PatchOfferV1Response{}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) SendOfferV1(ctx context.Context, req *pb.SendOfferV1Request) (*pb.SendOfferV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("SendOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.changeOfferStatus(ctx, req.Id, models.OfferStatusSent); err != nil {
+		log.Error().Err(err).Msg("SendOfferV1 -- failed")
+
+		return nil, err
+	}
+
+	log.Debug().Msg("SendOfferV1 - success")
+
+	return &pb.SendOfferV1Response{}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) AcceptOfferV1(ctx context.Context, req *pb.AcceptOfferV1Request) (*pb.AcceptOfferV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("AcceptOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.changeOfferStatus(ctx, req.Id, models.OfferStatusAccepted); err != nil {
+		log.Error().Err(err).Msg("AcceptOfferV1 -- failed")
+
+		return nil, err
+	}
+
+	log.Debug().Msg("AcceptOfferV1 - success")
+
+	return &pb.AcceptOfferV1Response{}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) DeclineOfferV1(ctx context.Context, req *pb.DeclineOfferV1Request) (*pb.DeclineOfferV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("DeclineOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.changeOfferStatus(ctx, req.Id, models.OfferStatusDeclined); err != nil {
+		log.Error().Err(err).Msg("DeclineOfferV1 -- failed")
+
+		return nil, err
+	}
+
+	log.Debug().Msg("DeclineOfferV1 - success")
+
+	return &pb.DeclineOfferV1Response{}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) WithdrawOfferV1(ctx context.Context, req *pb.WithdrawOfferV1Request) (*pb.WithdrawOfferV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("WithdrawOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.changeOfferStatus(ctx, req.Id, models.OfferStatusWithdrawn); err != nil {
+		log.Error().Err(err).Msg("WithdrawOfferV1 -- failed")
+
+		return nil, err
+	}
+
+	log.Debug().Msg("WithdrawOfferV1 - success")
+
+	return &pb.WithdrawOfferV1Response{}, nil
+}
+
+// changeOfferStatus moves the offer to the "next" status if the lifecycle allows it.
+// The returned error is already a gRPC status.
+func (o *offerAPI) changeOfferStatus(ctx context.Context, offerID uint64, next models.OfferStatus) error {
+	offer, err := o.repo.DescribeOffer(ctx, offerID)
+	if err != nil {
+		return repoError(err)
+	}
+
+	if !offer.Status.CanTransitionTo(next) {
+		return status.Errorf(codes.FailedPrecondition,
+			"%s: offer %d cannot be moved from %s to %s",
+			models.ErrInvalidStatusTransition, offerID, offer.Status, next)
+	}
+
+	if err := o.repo.UpdateOfferStatus(ctx, offerID, offer.Status, next); err != nil {
+		return repoError(err)
+	}
+
+	totalStatusChanged.WithLabelValues(next.String()).Inc()
+
+	return nil
+}
+
+// ----------------------------------------------------------------
+
 func (o *offerAPI) RemoveOfferV1(ctx context.Context, req *pb.RemoveOfferV1Request) (*pb.RemoveOfferV1Response, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("RemoveOfferV1 - invalid argument")
@@ -214,10 +723,17 @@ func (o *offerAPI) RemoveOfferV1(ctx context.Context, req *pb.RemoveOfferV1Reque
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := o.repo.RemoveOffer(ctx, req.Id); err != nil {
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		log.Error().Err(err).Msg("RemoveOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.repo.RemoveOffer(ctx, req.Id, version); err != nil {
 		log.Error().Err(err).Msg("RemoveOfferV1 -- failed")
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repoError(err)
 	}
 
 	totalSuccessDeleted.Inc()
@@ -229,6 +745,51 @@ func (o *offerAPI) RemoveOfferV1(ctx context.Context, req *pb.RemoveOfferV1Reque
 
 // ----------------------------------------------------------------
 
+func (o *offerAPI) RestoreOfferV1(ctx context.Context, req *pb.RestoreOfferV1Request) (*pb.RestoreOfferV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("RestoreOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.repo.RestoreOffer(ctx, req.Id); err != nil {
+		log.Error().Err(err).Msg("RestoreOfferV1 -- failed")
+
+		return nil, repoError(err)
+	}
+
+	totalSuccessRestored.Inc()
+
+	log.Debug().Msg("RestoreOfferV1 - success")
+
+	return &pb.RestoreOfferV1Response{}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) PurgeOffersV1(ctx context.Context, req *pb.PurgeOffersV1Request) (*pb.PurgeOffersV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("PurgeOffersV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	count, err := o.repo.PurgeOffers(ctx, req.DeletedBefore.AsTime())
+	if err != nil {
+		log.Error().Err(err).Msg("PurgeOffersV1 -- failed")
+
+		return nil, repoError(err)
+	}
+
+	log.Info().Uint64("count", count).Msg("PurgeOffersV1 - success")
+
+	return &pb.PurgeOffersV1Response{
+		Count: count,
+	}, nil
+}
+
+// ----------------------------------------------------------------
+
 func (o *offerAPI) TaskCreateOfferV1(ctx context.Context, req *pb.TaskCreateOfferV1Request) (*pb.TaskCreateOfferV1Response, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("TaskCreateOfferV1 - invalid argument")
@@ -242,13 +803,16 @@ func (o *offerAPI) TaskCreateOfferV1(ctx context.Context, req *pb.TaskCreateOffe
 		TeamID: req.TeamId,
 	}
 
-	if err := o.producer.CreateOffer(offer); err != nil {
+	taskID, err := o.startTask(ctx, models.TaskTypeCreateOffer, 1, func(taskID string) error {
+		return o.producer.CreateOffer(taskID, offer)
+	})
+	if err != nil {
 		log.Error().Err(err).Msg("TaskCreateOfferV1 -- failed")
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
-	return &pb.TaskCreateOfferV1Response{}, nil
+	return &pb.TaskCreateOfferV1Response{TaskId: taskID}, nil
 }
 
 // ----------------------------------------------------------------
@@ -270,11 +834,25 @@ func (o *offerAPI) TaskMultiCreateOfferV1(ctx context.Context, req *pb.TaskMulti
 		}
 	}
 
-	o.producer.MultiCreateOffers(offers, req.BatchSize)
+	batches, err := utils.SplitOffersToBatches(offers, uint(req.BatchSize))
+	if err != nil {
+		log.Error().Err(err).Msg("TaskMultiCreateOfferV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	taskID, err := o.startTask(ctx, models.TaskTypeMultiCreateOffer, len(batches), func(taskID string) error {
+		return o.producer.MultiCreateOffers(taskID, batches)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("TaskMultiCreateOfferV1 -- failed")
+
+		return nil, err
+	}
 
 	log.Debug().Msg("TaskMultiCreateOfferV1 -- success")
 
-	return &pb.TaskMultiCreateOfferV1Response{}, nil
+	return &pb.TaskMultiCreateOfferV1Response{TaskId: taskID}, nil
 }
 
 // ----------------------------------------------------------------
@@ -293,13 +871,16 @@ func (o *offerAPI) TaskUpdateOfferV1(ctx context.Context, req *pb.TaskUpdateOffe
 		TeamID: req.TeamId,
 	}
 
-	if err := o.producer.CreateOffer(data); err != nil {
+	taskID, err := o.startTask(ctx, models.TaskTypeUpdateOffer, 1, func(taskID string) error {
+		return o.producer.UpdateOffer(taskID, data)
+	})
+	if err != nil {
 		log.Error().Err(err).Msg("TaskUpdateOfferV1 -- failed")
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
-	return &pb.TaskUpdateOfferV1Response{}, nil
+	return &pb.TaskUpdateOfferV1Response{TaskId: taskID}, nil
 }
 
 // ----------------------------------------------------------------
@@ -311,11 +892,278 @@ func (o *offerAPI) TaskRemoveOfferV1(ctx context.Context, req *pb.TaskRemoveOffe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := o.producer.DeleteOffer(req.Id); err != nil {
+	taskID, err := o.startTask(ctx, models.TaskTypeRemoveOffer, 1, func(taskID string) error {
+		return o.producer.DeleteOffer(taskID, req.Id)
+	})
+	if err != nil {
 		log.Error().Err(err).Msg("TaskRemoveOfferV1 -- failed")
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+
+	return &pb.TaskRemoveOfferV1Response{TaskId: taskID}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (o *offerAPI) GetTaskStatusV1(ctx context.Context, req *pb.GetTaskStatusV1Request) (*pb.GetTaskStatusV1Response, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("GetTaskStatusV1 - invalid argument")
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	task, err := o.repo.GetTask(ctx, req.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("GetTaskStatusV1 -- failed")
+
+		return nil, repoError(err)
+	}
+
+	return &pb.GetTaskStatusV1Response{Task: taskToPb(task)}, nil
+}
+
+// ----------------------------------------------------------------
+
+// startTask stores a queued task of "parts" Kafka messages and sends them with "publish".
+// The task fails with the error of "publish", the error is returned as a gRPC status error.
+// A task without messages is stored succeeded.
+func (o *offerAPI) startTask(
+	ctx context.Context,
+	taskType models.TaskType,
+	parts int,
+	publish func(taskID string) error,
+) (string, error) {
+	taskID, err := uuid.New()
+	if err != nil {
+		return "", internalError(err)
+	}
+
+	task := models.Task{
+		ID:     taskID,
+		Type:   taskType,
+		Status: models.TaskStatusQueued,
+		Parts:  uint32(parts),
+	}
+
+	if parts == 0 {
+		task.Status = models.TaskStatusSucceeded
+	}
+
+	if err := o.repo.CreateTask(ctx, &task); err != nil {
+		return "", repoError(err)
+	}
+
+	if parts == 0 {
+		return taskID, nil
+	}
+
+	if err := publish(taskID); err != nil {
+		if finishErr := o.repo.FinishTaskPart(ctx, taskID, nil, err); finishErr != nil {
+			log.Error().Err(finishErr).Str("task_id", taskID).Msg("Failed to fail the task")
+		}
+
+		return "", internalError(err)
 	}
 
-	return &pb.TaskRemoveOfferV1Response{}, nil
+	return taskID, nil
+}
+
+// repoError converts an error of the repository to a gRPC status error.
+func repoError(err error) error {
+	switch {
+	case errors.Is(err, models.ErrOfferVersionMismatch):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, repo.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repo.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repo.ErrConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return internalError(err)
+}
+
+// internalError logs the cause and returns the codes.Internal error without its details,
+// so the driver and network errors don't reach the clients.
+func internalError(err error) error {
+	log.Error().Err(err).Msg("Internal error")
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+func offerToPb(offer *models.Offer) *pb.Offer {
+	result := &pb.Offer{
+		Id:      offer.ID,
+		UserId:  offer.UserID,
+		Grade:   offer.Grade,
+		TeamId:  offer.TeamID,
+		Status:  pb.OfferStatus(offer.Status),
+		Version: offer.Version,
+	}
+
+	if offer.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*offer.ExpiresAt)
+	}
+
+	if !offer.CreatedAt.IsZero() {
+		result.CreatedAt = timestamppb.New(offer.CreatedAt)
+	}
+
+	if !offer.UpdatedAt.IsZero() {
+		result.UpdatedAt = timestamppb.New(offer.UpdatedAt)
+	}
+
+	return result
+}
+
+func taskToPb(task *models.Task) *pb.Task {
+	return &pb.Task{
+		Id:        task.ID,
+		Type:      pb.TaskType(task.Type),
+		Status:    pb.TaskStatus(task.Status),
+		Reason:    task.Reason,
+		OfferIds:  task.OfferIDs,
+		Parts:     task.Parts,
+		PartsDone: task.PartsDone,
+		CreatedAt: timestamppb.New(task.CreatedAt),
+		UpdatedAt: timestamppb.New(task.UpdatedAt),
+	}
+}
+
+// validatePatch checks the mask paths and validates only the masked fields of the offer.
+func validatePatch(offer *pb.Offer, paths []string) error {
+	if len(paths) == 0 {
+		return errors.New("update_mask must not be empty")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "user_id":
+			if offer.UserId == 0 {
+				return errors.New("invalid Offer.UserId: value must be greater than 0")
+			}
+		case "team_id":
+			if offer.TeamId == 0 {
+				return errors.New("invalid Offer.TeamId: value must be greater than 0")
+			}
+		case "grade":
+			if offer.Grade == 0 {
+				return errors.New("invalid Offer.Grade: value must be greater than 0")
+			}
+		case "expires_at":
+			if offer.ExpiresAt != nil && !offer.ExpiresAt.AsTime().After(time.Now()) {
+				return errors.New("invalid Offer.ExpiresAt: value must be greater than now")
+			}
+		default:
+			return fmt.Errorf("update_mask: unknown path %q", path)
+		}
+	}
+
+	return nil
+}
+
+// expectedVersion returns the version from the request or, if it is not set, from the `If-Match` metadata.
+// Both the plain number and the quoted entity tag returned by the gateway are accepted, "*" matches any version.
+func expectedVersion(ctx context.Context, requested uint64) (uint64, error) {
+	if requested != 0 {
+		return requested, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	values := md.Get(IfMatchMetadataKey)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	tag := strings.Trim(strings.TrimPrefix(strings.TrimSpace(values[0]), "W/"), `"`)
+	if tag == "*" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseUint(tag, 10, 64)
+	if err != nil {
+		return 0, errors.New("if-match must contain an offer version")
+	}
+
+	return version, nil
+}
+
+func paginationFromPb(pagination *pb.PaginationInput) (models.PaginationInput, error) {
+	result := models.PaginationInput{
+		Take:      pagination.Take,
+		Skip:      pagination.Skip,
+		SkipCount: pagination.SkipCount,
+	}
+
+	if pagination.PageToken == "" {
+		return result, nil
+	}
+
+	if pagination.Skip != 0 {
+		return result, errors.New("skip can't be combined with page_token")
+	}
+
+	token, err := models.DecodePageToken(pagination.PageToken)
+	if err != nil {
+		return result, err
+	}
+
+	result.PageToken = token
+
+	return result, nil
+}
+
+func nextPageToken(pagInfo *models.PaginationInfo) string {
+	if pagInfo.NextPageToken == nil {
+		return ""
+	}
+
+	return pagInfo.NextPageToken.Encode()
+}
+
+func filterFromPb(filter *pb.OfferFilter) models.OfferFilter {
+	return models.OfferFilter{
+		UserIDs:        filter.GetUserIds(),
+		TeamIDs:        filter.GetTeamIds(),
+		GradeMin:       filter.GetGradeMin(),
+		GradeMax:       filter.GetGradeMax(),
+		IncludeDeleted: filter.GetIncludeDeleted(),
+	}
+}
+
+func revisionToPb(revision *models.OfferRevision) *pb.OfferRevision {
+	changes := make([]*pb.OfferFieldChange, len(revision.Changes))
+
+	for i, change := range revision.Changes {
+		changes[i] = &pb.OfferFieldChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		}
+	}
+
+	return &pb.OfferRevision{
+		Revision:  revision.Revision,
+		Action:    pb.OfferHistoryAction(revision.Action),
+		Actor:     revision.Actor,
+		ChangedAt: timestamppb.New(revision.CreatedAt),
+		Changes:   changes,
+	}
+}
+
+// timestampToTime converts an optional protobuf timestamp, `nil` stays `nil`.
+func timestampToTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
 }
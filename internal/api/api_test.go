@@ -3,8 +3,10 @@ package api_test
 import (
 	"context"
 	"errors"
+	"io"
 	"log"
 	"net"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -13,11 +15,16 @@ import (
 	"github.com/ozoncp/ocp-offer-api/internal/api"
 	"github.com/ozoncp/ocp-offer-api/internal/mocks"
 	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+	"github.com/ozoncp/ocp-offer-api/internal/watch"
 	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ = Describe("OcpOfferApiService", func() {
@@ -28,6 +35,7 @@ var _ = Describe("OcpOfferApiService", func() {
 		ctrl      *gomock.Controller
 		mRepo     *mocks.MockIRepository
 		mProducer *mocks.MockIProducer
+		hub       *watch.Hub
 		ctx       context.Context
 		conn      *grpc.ClientConn
 		client    pb.OcpOfferApiServiceClient
@@ -42,7 +50,9 @@ var _ = Describe("OcpOfferApiService", func() {
 		listener = bufconn.Listen(bufSize)
 		server := grpc.NewServer()
 
-		pb.RegisterOcpOfferApiServiceServer(server, api.NewOfferAPI(mRepo, mProducer))
+		hub = watch.NewHub()
+
+		pb.RegisterOcpOfferApiServiceServer(server, api.NewOfferAPI(mRepo, mProducer, hub))
 		done = make(chan struct{})
 
 		go func() {
@@ -78,7 +88,7 @@ var _ = Describe("OcpOfferApiService", func() {
 		When("invalid arguments", func() {
 			It("req.UserId, req.Grade, req.TeamId = 0 returns an error codes.InvalidArgument", func() {
 				mRepo.EXPECT().
-					CreateOffer(gomock.Any(), gomock.Any()).
+					CreateOffer(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 
 				req := &pb.CreateOfferV1Request{UserId: 0, Grade: 0, TeamId: 0}
@@ -87,29 +97,83 @@ var _ = Describe("OcpOfferApiService", func() {
 				Expect(res).Should(BeNil())
 				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
 			})
+
+			It("req.ExpiresAt in the past returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					CreateOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.CreateOfferV1Request{
+					UserId:    1,
+					Grade:     2,
+					TeamId:    3,
+					ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+				}
+				res, err := client.CreateOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
 		})
 
 		When("unknown error from CreateOffer", func() {
-			It("returns an error", func() {
+			It("returns an error without its details", func() {
 				mRepo.EXPECT().
-					CreateOffer(gomock.Any(), gomock.Any()).
+					CreateOffer(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(uint64(0), errors.New(""))
+					Return(models.CreateResult{}, errors.New("dial tcp 10.0.0.1:5432: connection refused"))
 
 				req := &pb.CreateOfferV1Request{UserId: 1, Grade: 2, TeamId: 3}
 				res, err := client.CreateOfferV1(ctx, req)
 
 				Expect(res).Should(BeNil())
 				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
+				Expect(status.Convert(err).Message()).Should(Equal("internal error"))
+			})
+		})
+
+		When("offer with the same user and team exists", func() {
+			It("returns an error codes.AlreadyExists", func() {
+				mRepo.EXPECT().
+					CreateOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(models.CreateResult{}, &repo.Error{Kind: repo.ErrAlreadyExists, Message: "already exists"})
+
+				req := &pb.CreateOfferV1Request{UserId: 1, Grade: 2, TeamId: 3}
+				res, err := client.CreateOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.AlreadyExists))
+			})
+		})
+
+		When("skip policy and the offer exists", func() {
+			It("returns the id of the existing offer", func() {
+				mRepo.EXPECT().
+					CreateOffer(gomock.Any(), gomock.Any(), models.ConflictPolicySkip).
+					Times(1).
+					Return(models.CreateResult{ID: 5, Action: models.CreateActionSkipped}, nil)
+
+				req := &pb.CreateOfferV1Request{
+					UserId:         1,
+					Grade:          2,
+					TeamId:         3,
+					ConflictPolicy: pb.ConflictPolicy_CONFLICT_POLICY_SKIP,
+				}
+				res, err := client.CreateOfferV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res.Id).Should(BeEquivalentTo(5))
+				Expect(res.Result).Should(Equal(pb.CreateOfferResult_CREATE_OFFER_RESULT_SKIPPED))
 			})
 		})
 
 		When("normal case", func() {
 			It("all props corrected", func() {
 				mRepo.EXPECT().
-					CreateOffer(gomock.Any(), gomock.Any()).
+					CreateOffer(gomock.Any(), gomock.Any(), models.ConflictPolicyUnspecified).
 					Times(1).
-					Return(uint64(1), nil)
+					Return(models.CreateResult{ID: 1, Action: models.CreateActionInserted}, nil)
 
 				req := &pb.CreateOfferV1Request{UserId: 1, Grade: 2, TeamId: 3}
 				res, err := client.CreateOfferV1(ctx, req)
@@ -120,6 +184,96 @@ var _ = Describe("OcpOfferApiService", func() {
 		})
 	})
 
+	Context("gRPC call to MultiCreateOfferV1 function", func() {
+		When("an offer fails", func() {
+			It("returns its error and creates nothing", func() {
+				mRepo.EXPECT().
+					MultiCreateOffer(gomock.Any(), gomock.Any(), gomock.Any(), false).
+					Times(1).
+					Return(nil, &repo.Error{Kind: repo.ErrAlreadyExists, Message: "already exists"})
+
+				req := &pb.MultiCreateOfferV1Request{
+					Offers: []*pb.CreateOfferV1Request{
+						{UserId: 1, Grade: 2, TeamId: 3},
+						{UserId: 1, Grade: 2, TeamId: 3},
+					},
+				}
+				res, err := client.MultiCreateOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.AlreadyExists))
+			})
+		})
+
+		When("best effort mode", func() {
+			It("returns the ids of the created offers and the errors of the failed ones", func() {
+				mRepo.EXPECT().
+					MultiCreateOffer(gomock.Any(), gomock.Any(), models.ConflictPolicyFail, true).
+					Times(1).
+					Return([]models.CreateResult{
+						{ID: 7, Action: models.CreateActionInserted},
+						{Err: &repo.Error{Kind: repo.ErrAlreadyExists, Message: "already exists"}},
+						{ID: 8, Action: models.CreateActionInserted},
+					}, nil)
+
+				req := &pb.MultiCreateOfferV1Request{
+					Offers: []*pb.CreateOfferV1Request{
+						{UserId: 1, Grade: 2, TeamId: 3},
+						{UserId: 2, Grade: 2, TeamId: 3},
+						{UserId: 3, Grade: 2, TeamId: 3},
+					},
+					BestEffort:     true,
+					ConflictPolicy: pb.ConflictPolicy_CONFLICT_POLICY_FAIL,
+				}
+				res, err := client.MultiCreateOfferV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res.Count).Should(BeEquivalentTo(2))
+				Expect(res.Ids).Should(Equal([]uint64{7, 0, 8}))
+				Expect(res.Errors).Should(HaveLen(1))
+				Expect(res.Errors[0].Index).Should(BeEquivalentTo(1))
+				Expect(res.Errors[0].Code).Should(Equal(codes.AlreadyExists.String()))
+				Expect(res.Results).Should(Equal([]pb.CreateOfferResult{
+					pb.CreateOfferResult_CREATE_OFFER_RESULT_INSERTED,
+					pb.CreateOfferResult_CREATE_OFFER_RESULT_UNSPECIFIED,
+					pb.CreateOfferResult_CREATE_OFFER_RESULT_INSERTED,
+				}))
+			})
+		})
+
+		When("update grade policy", func() {
+			It("reports the updated and skipped offers", func() {
+				mRepo.EXPECT().
+					MultiCreateOffer(gomock.Any(), gomock.Any(), models.ConflictPolicyUpdateGrade, false).
+					Times(1).
+					Return([]models.CreateResult{
+						{ID: 7, Action: models.CreateActionUpdated},
+						{ID: 8, Action: models.CreateActionSkipped},
+						{ID: 9, Action: models.CreateActionInserted},
+					}, nil)
+
+				req := &pb.MultiCreateOfferV1Request{
+					Offers: []*pb.CreateOfferV1Request{
+						{UserId: 1, Grade: 2, TeamId: 3},
+						{UserId: 2, Grade: 2, TeamId: 3},
+						{UserId: 3, Grade: 2, TeamId: 3},
+					},
+					ConflictPolicy: pb.ConflictPolicy_CONFLICT_POLICY_UPDATE_GRADE,
+				}
+				res, err := client.MultiCreateOfferV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res.Count).Should(BeEquivalentTo(1))
+				Expect(res.Ids).Should(Equal([]uint64{7, 8, 9}))
+				Expect(res.Results).Should(Equal([]pb.CreateOfferResult{
+					pb.CreateOfferResult_CREATE_OFFER_RESULT_UPDATED,
+					pb.CreateOfferResult_CREATE_OFFER_RESULT_SKIPPED,
+					pb.CreateOfferResult_CREATE_OFFER_RESULT_INSERTED,
+				}))
+			})
+		})
+	})
+
 	Context("gRPC call to DescribeOfferV1 function", func() {
 		When("invalid arguments", func() {
 			It("req.Id = 0 returns an error codes.InvalidArgument", func() {
@@ -150,6 +304,22 @@ var _ = Describe("OcpOfferApiService", func() {
 			})
 		})
 
+		When("offer is not found", func() {
+			It("returns an error codes.NotFound", func() {
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, &repo.Error{Kind: repo.ErrNotFound, Message: "offer 1 not found"})
+
+				req := &pb.DescribeOfferV1Request{Id: 1}
+				res, err := client.DescribeOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.NotFound))
+				Expect(status.Convert(err).Message()).Should(Equal("offer 1 not found"))
+			})
+		})
+
 		When("normal case", func() {
 			It("all props corrected", func() {
 
@@ -172,198 +342,1357 @@ var _ = Describe("OcpOfferApiService", func() {
 		})
 	})
 
-	Context("gRPC call to ListOfferV1 function", func() {
+	Context("gRPC call to WatchOffersV1 function", func() {
 		When("invalid arguments", func() {
-			It("initialized values returns an error codes.InvalidArgument", func() {
+			It("broken resume_token returns an error codes.InvalidArgument", func() {
 				mRepo.EXPECT().
-					ListOffer(gomock.Any(), gomock.Any()).
+					ListOfferEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 
-				req := &pb.ListOfferV1Request{
-					Pagination: &pb.PaginationInput{},
+				stream, err := client.WatchOffersV1(ctx, &pb.WatchOffersV1Request{ResumeToken: "broken"})
+				Expect(err).Should(BeNil())
+
+				_, err = stream.Recv()
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("unknown error from LastOfferEventPosition", func() {
+			It("returns an error codes.Internal", func() {
+				mRepo.EXPECT().
+					LastOfferEventPosition(gomock.Any()).
+					Times(1).
+					Return(uint64(0), errors.New(""))
+
+				stream, err := client.WatchOffersV1(ctx, &pb.WatchOffersV1Request{})
+				Expect(err).Should(BeNil())
+
+				_, err = stream.Recv()
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
+			})
+		})
+
+		When("resume_token is set", func() {
+			It("streams the changes after the token in the order of positions", func() {
+				watchCtx, cancel := context.WithCancel(ctx)
+				defer cancel()
+
+				filter := models.OfferFilter{TeamIDs: []uint64{3}, IncludeDeleted: true}
+
+				gomock.InOrder(
+					mRepo.EXPECT().
+						ListOfferEvents(gomock.Any(), uint64(5), filter, gomock.Any()).
+						Times(1).
+						Return([]models.OfferEvent{
+							{
+								OfferRevision: models.OfferRevision{ID: 4, OfferID: 1, Revision: 2, Action: models.OfferHistoryActionUpdated},
+								Position:      6,
+								Offer:         models.Offer{ID: 1, TeamID: 3},
+							},
+						}, nil),
+					mRepo.EXPECT().
+						ListOfferEvents(gomock.Any(), uint64(6), filter, gomock.Any()).
+						AnyTimes().
+						Return([]models.OfferEvent{}, nil),
+				)
+
+				req := &pb.WatchOffersV1Request{
+					ResumeToken: models.EncodeResumeToken(5),
+					TeamIds:     []uint64{3},
 				}
 
-				res, err := client.ListOfferV1(ctx, req)
+				stream, err := client.WatchOffersV1(watchCtx, req)
+				Expect(err).Should(BeNil())
 
-				Expect(res).Should(BeNil())
+				res, err := stream.Recv()
+				Expect(err).Should(BeNil())
+				Expect(res.OfferId).Should(BeEquivalentTo(1))
+				Expect(res.Revision.Revision).Should(BeEquivalentTo(2))
+				Expect(res.ResumeToken).Should(Equal(models.EncodeResumeToken(6)))
+
+				cancel()
+
+				_, err = stream.Recv()
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Canceled))
+			})
+		})
+
+		When("resume_token is not set", func() {
+			It("streams the changes made after the call on notification", func() {
+				watchCtx, cancel := context.WithCancel(ctx)
+				defer cancel()
+
+				listed := make(chan struct{})
+
+				gomock.InOrder(
+					mRepo.EXPECT().
+						LastOfferEventPosition(gomock.Any()).
+						Times(1).
+						Return(uint64(10), nil),
+					mRepo.EXPECT().
+						ListOfferEvents(gomock.Any(), uint64(10), gomock.Any(), gomock.Any()).
+						Times(1).
+						DoAndReturn(func(context.Context, uint64, models.OfferFilter, uint64) ([]models.OfferEvent, error) {
+							close(listed)
+
+							return []models.OfferEvent{}, nil
+						}),
+					mRepo.EXPECT().
+						ListOfferEvents(gomock.Any(), uint64(10), gomock.Any(), gomock.Any()).
+						Times(1).
+						Return([]models.OfferEvent{
+							{
+								OfferRevision: models.OfferRevision{ID: 9, OfferID: 2, Revision: 1, Action: models.OfferHistoryActionCreated},
+								Position:      11,
+								Offer:         models.Offer{ID: 2},
+							},
+						}, nil),
+					mRepo.EXPECT().
+						ListOfferEvents(gomock.Any(), uint64(11), gomock.Any(), gomock.Any()).
+						AnyTimes().
+						Return([]models.OfferEvent{}, nil),
+				)
+
+				stream, err := client.WatchOffersV1(watchCtx, &pb.WatchOffersV1Request{})
+				Expect(err).Should(BeNil())
+
+				Eventually(listed).Should(BeClosed())
+				hub.Notify()
+
+				res, err := stream.Recv()
+				Expect(err).Should(BeNil())
+				Expect(res.OfferId).Should(BeEquivalentTo(2))
+				Expect(res.Offer.Id).Should(BeEquivalentTo(2))
+			})
+		})
+	})
+
+	Context("gRPC call to ExportOffersV1 function", func() {
+		When("invalid arguments", func() {
+			It("grade_min > grade_max returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					ExportOffers(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.ExportOffersV1Request{
+					Filter: &pb.OfferFilter{GradeMin: 5, GradeMax: 3},
+				}
+
+				stream, err := client.ExportOffersV1(ctx, req)
+				Expect(err).Should(BeNil())
+
+				_, err = stream.Recv()
 				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
 			})
 		})
 
-		When("unknown error from ListOffer", func() {
-			It("returns an error", func() {
+		When("unknown error from ExportOffers", func() {
+			It("returns an error codes.Internal after the sent offers", func() {
 				mRepo.EXPECT().
-					ListOffer(gomock.Any(), gomock.Any()).
+					ExportOffers(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(nil, nil, errors.New(""))
+					DoAndReturn(func(_ context.Context, _ models.OfferFilter, fn func(*models.Offer) error) error {
+						if err := fn(&models.Offer{ID: 1}); err != nil {
+							return err
+						}
 
-				req := &pb.ListOfferV1Request{
-					Pagination: &pb.PaginationInput{
-						Take: 1,
-						Skip: 10,
-					},
-				}
+						return errors.New("")
+					})
 
-				res, err := client.ListOfferV1(ctx, req)
+				stream, err := client.ExportOffersV1(ctx, &pb.ExportOffersV1Request{})
+				Expect(err).Should(BeNil())
 
-				Expect(res).Should(BeNil())
+				res, err := stream.Recv()
+				Expect(err).Should(BeNil())
+				Expect(res.Offer.Id).Should(BeEquivalentTo(1))
+
+				_, err = stream.Recv()
 				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
 			})
 		})
 
 		When("normal case", func() {
-			It("all props corrected", func() {
-				offers := make([]models.Offer, 0)
+			It("streams all offers", func() {
+				mRepo.EXPECT().
+					ExportOffers(gomock.Any(), models.OfferFilter{TeamIDs: []uint64{3}}, gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ models.OfferFilter, fn func(*models.Offer) error) error {
+						for id := uint64(1); id <= 3; id++ {
+							if err := fn(&models.Offer{ID: id, TeamID: 3}); err != nil {
+								return err
+							}
+						}
+
+						return nil
+					})
+
+				req := &pb.ExportOffersV1Request{
+					Filter: &pb.OfferFilter{TeamIds: []uint64{3}},
+				}
 
-				pagInfo := models.PaginationInfo{
-					Page:            1,
-					TotalPages:      1,
-					TotalItems:      0,
-					PerPage:         0,
-					HasNextPage:     false,
-					HasPreviousPage: false,
+				stream, err := client.ExportOffersV1(ctx, req)
+				Expect(err).Should(BeNil())
+
+				ids := make([]uint64, 0)
+				for {
+					res, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					Expect(err).Should(BeNil())
+					ids = append(ids, res.Offer.Id)
 				}
 
+				Expect(ids).Should(Equal([]uint64{1, 2, 3}))
+			})
+		})
+	})
+
+	Context("gRPC call to BatchGetOffersV1 function", func() {
+		When("invalid arguments", func() {
+			It("duplicate ids return an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					BatchGetOffers(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				res, err := client.BatchGetOffersV1(ctx, &pb.BatchGetOffersV1Request{Ids: []uint64{1, 1}})
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("unknown error from BatchGetOffers", func() {
+			It("returns an error codes.Internal", func() {
 				mRepo.EXPECT().
-					ListOffer(gomock.Any(), gomock.Any()).
+					BatchGetOffers(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(offers, &pagInfo, nil)
+					Return(nil, errors.New(""))
 
-				req := &pb.ListOfferV1Request{
-					Pagination: &pb.PaginationInput{
-						Take: 1,
-						Skip: 10,
-					},
-				}
+				res, err := client.BatchGetOffersV1(ctx, &pb.BatchGetOffersV1Request{Ids: []uint64{1}})
 
-				res, err := client.ListOfferV1(ctx, req)
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
+			})
+		})
+
+		When("some offers are missing", func() {
+			It("returns the found offers in request order and the missing ids", func() {
+				mRepo.EXPECT().
+					BatchGetOffers(gomock.Any(), []uint64{3, 1, 2, 4}).
+					Times(1).
+					Return([]models.Offer{{ID: 1}, {ID: 3}}, nil)
+
+				res, err := client.BatchGetOffersV1(ctx, &pb.BatchGetOffersV1Request{Ids: []uint64{3, 1, 2, 4}})
 
-				Expect(res).ShouldNot(BeNil())
-				Expect(res.Pagination).Should(
-					BeEquivalentTo(&pb.PaginationInfo{
-						Page:            pagInfo.Page,
-						TotalPages:      pagInfo.TotalPages,
-						TotalItems:      pagInfo.TotalItems,
-						PerPage:         pagInfo.PerPage,
-						HasNextPage:     pagInfo.HasNextPage,
-						HasPreviousPage: pagInfo.HasPreviousPage,
-					}))
 				Expect(err).Should(BeNil())
+				Expect(res.Offers).Should(HaveLen(2))
+				Expect(res.Offers[0].Id).Should(BeEquivalentTo(3))
+				Expect(res.Offers[1].Id).Should(BeEquivalentTo(1))
+				Expect(res.NotFoundIds).Should(Equal([]uint64{2, 4}))
 			})
 		})
 	})
 
-	Context("gRPC call to UpdateOfferV1 function", func() {
+	Context("gRPC call to GetOfferStatsV1 function", func() {
 		When("invalid arguments", func() {
-			It("initialized values returns an error codes.InvalidArgument", func() {
+			It("empty group_by returns an error codes.InvalidArgument", func() {
 				mRepo.EXPECT().
-					UpdateOffer(gomock.Any(), gomock.Any()).
+					GetOfferStats(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 
-				req := &pb.UpdateOfferV1Request{
-					Id:     0,
-					UserId: 0,
-					Grade:  0,
-					TeamId: 0,
-				}
-
-				res, err := client.UpdateOfferV1(ctx, req)
+				res, err := client.GetOfferStatsV1(ctx, &pb.GetOfferStatsV1Request{})
 
 				Expect(res).Should(BeNil())
 				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
 			})
-		})
 
-		When("unknown error from RemoveOffer", func() {
-			It("returns an error", func() {
+			It("inverted time range returns an error codes.InvalidArgument", func() {
 				mRepo.EXPECT().
-					UpdateOffer(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(errors.New(""))
+					GetOfferStats(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
 
-				req := &pb.UpdateOfferV1Request{
-					Id:     1,
-					UserId: 2,
-					Grade:  3,
-					TeamId: 4,
+				now := time.Now()
+				req := &pb.GetOfferStatsV1Request{
+					GroupBy:     []pb.OfferStatsDimension{pb.OfferStatsDimension_OFFER_STATS_DIMENSION_TEAM},
+					CreatedFrom: timestamppb.New(now),
+					CreatedTo:   timestamppb.New(now.Add(-time.Hour)),
 				}
 
-				res, err := client.UpdateOfferV1(ctx, req)
+				res, err := client.GetOfferStatsV1(ctx, req)
 
 				Expect(res).Should(BeNil())
-				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
 			})
 		})
 
 		When("normal case", func() {
-			It("all props corrected", func() {
+			It("returns the groups and their total", func() {
+				groupBy := []models.OfferStatsDimension{models.OfferStatsDimensionGrade, models.OfferStatsDimensionStatus}
+				filter := models.OfferStatsFilter{
+					OfferFilter: models.OfferFilter{TeamIDs: []uint64{3}},
+				}
 
 				mRepo.EXPECT().
-					UpdateOffer(gomock.Any(), gomock.Any()).
+					GetOfferStats(gomock.Any(), groupBy, filter).
 					Times(1).
-					Return(nil)
+					Return([]models.OfferStatsGroup{
+						{Grade: 1, Status: models.OfferStatusDraft, Count: 2},
+						{Grade: 1, Status: models.OfferStatusSent, Count: 5},
+					}, nil)
 
-				req := &pb.UpdateOfferV1Request{
-					Id:     1,
-					UserId: 2,
-					Grade:  3,
-					TeamId: 4,
+				req := &pb.GetOfferStatsV1Request{
+					GroupBy: []pb.OfferStatsDimension{
+						pb.OfferStatsDimension_OFFER_STATS_DIMENSION_GRADE,
+						pb.OfferStatsDimension_OFFER_STATS_DIMENSION_STATUS,
+					},
+					TeamId: 3,
 				}
 
-				res, err := client.UpdateOfferV1(ctx, req)
+				res, err := client.GetOfferStatsV1(ctx, req)
 
-				Expect(res).ShouldNot(BeNil())
 				Expect(err).Should(BeNil())
+				Expect(res.Groups).Should(HaveLen(2))
+				Expect(res.Groups[1].Status).Should(Equal(pb.OfferStatus_OFFER_STATUS_SENT))
+				Expect(res.Total).Should(BeEquivalentTo(7))
 			})
 		})
 	})
 
-	Context("gRPC call to RemoveOfferV1 function", func() {
+	Context("gRPC call to GetOfferHistoryV1 function", func() {
 		When("invalid arguments", func() {
-			It("initialized values returns an error codes.InvalidArgument", func() {
+			It("req.Id = 0 returns an error codes.InvalidArgument", func() {
 				mRepo.EXPECT().
-					RemoveOffer(gomock.Any(), gomock.Any()).
+					GetOfferHistory(gomock.Any(), gomock.Any()).
 					Times(0)
 
-				req := &pb.RemoveOfferV1Request{
-					Id: 0,
-				}
-
-				res, err := client.RemoveOfferV1(ctx, req)
+				req := &pb.GetOfferHistoryV1Request{Id: 0}
+				res, err := client.GetOfferHistoryV1(ctx, req)
 
 				Expect(res).Should(BeNil())
 				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
 			})
 		})
 
-		When("unknown error from RemoveOffer", func() {
-			It("returns an error", func() {
+		When("the offer doesn't exist", func() {
+			It("returns an error codes.NotFound", func() {
 				mRepo.EXPECT().
-					RemoveOffer(gomock.Any(), gomock.Any()).
+					GetOfferHistory(gomock.Any(), uint64(1)).
 					Times(1).
-					Return(errors.New(""))
+					Return([]models.OfferRevision{}, nil)
 
-				req := &pb.RemoveOfferV1Request{Id: 1}
-				res, err := client.RemoveOfferV1(ctx, req)
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(nil, &repo.Error{Kind: repo.ErrNotFound, Message: "offer 1 not found"})
+
+				req := &pb.GetOfferHistoryV1Request{Id: 1}
+				res, err := client.GetOfferHistoryV1(ctx, req)
 
 				Expect(res).Should(BeNil())
-				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.NotFound))
 			})
 		})
 
-		When("normal case", func() {
-			It("all props corrected", func() {
+		When("the offer has no history", func() {
+			It("returns an empty list", func() {
+				mRepo.EXPECT().
+					GetOfferHistory(gomock.Any(), uint64(1)).
+					Times(1).
+					Return([]models.OfferRevision{}, nil)
 
 				mRepo.EXPECT().
-					RemoveOffer(gomock.Any(), gomock.Any()).
+					DescribeOffer(gomock.Any(), uint64(1)).
 					Times(1).
-					Return(nil)
+					Return(&models.Offer{ID: 1}, nil)
 
-				req := &pb.RemoveOfferV1Request{Id: 1}
-				res, err := client.RemoveOfferV1(ctx, req)
+				req := &pb.GetOfferHistoryV1Request{Id: 1}
+				res, err := client.GetOfferHistoryV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res.Revisions).Should(BeEmpty())
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+				mRepo.EXPECT().
+					GetOfferHistory(gomock.Any(), uint64(1)).
+					Times(1).
+					Return([]models.OfferRevision{
+						{
+							OfferID:  1,
+							Revision: 1,
+							Action:   models.OfferHistoryActionCreated,
+							Actor:    "recruiter",
+						},
+						{
+							OfferID:  1,
+							Revision: 2,
+							Action:   models.OfferHistoryActionUpdated,
+							Actor:    "recruiter",
+							Changes:  []models.FieldChange{{Field: "grade", OldValue: "1", NewValue: "2"}},
+						},
+					}, nil)
+
+				req := &pb.GetOfferHistoryV1Request{Id: 1}
+				res, err := client.GetOfferHistoryV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res.Revisions).Should(HaveLen(2))
+				Expect(res.Revisions[1].Action).Should(Equal(pb.OfferHistoryAction_OFFER_HISTORY_ACTION_UPDATED))
+				Expect(res.Revisions[1].Changes[0].NewValue).Should(Equal("2"))
+			})
+		})
+	})
+
+	Context("gRPC call to ListOfferV1 function", func() {
+		When("invalid arguments", func() {
+			It("initialized values returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{},
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+
+			It("grade_min > grade_max returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{Take: 1},
+					Filter:     &pb.OfferFilter{GradeMin: 5, GradeMax: 3},
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("order_by is set", func() {
+			It("unknown field returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{Take: 10},
+					OrderBy:    "status desc",
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+
+			It("token of another order returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{Take: 10, PageToken: (&models.PageToken{LastID: 10}).Encode()},
+					OrderBy:    "grade desc",
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+
+			It("passes the order to ListOffer", func() {
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), gomock.Any(), gomock.Any(), models.OfferOrder{Field: "created_at", Desc: true}).
+					Times(1).
+					Return([]models.Offer{}, &models.PaginationInfo{Page: 1}, nil)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{Take: 10},
+					OrderBy:    "created_at desc",
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res).ShouldNot(BeNil())
+			})
+		})
+
+		When("page token is set", func() {
+			It("invalid token returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{Take: 10, PageToken: "not-a-token"},
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+
+			It("skip with token returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{
+						Take:      10,
+						Skip:      10,
+						PageToken: (&models.PageToken{LastID: 10}).Encode(),
+					},
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+
+			It("continues after the token and returns the next one", func() {
+				pagination := models.PaginationInput{
+					Take:      10,
+					PageToken: &models.PageToken{LastID: 10},
+					SkipCount: true,
+				}
+				next := &models.PageToken{LastID: 20}
+
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), pagination, gomock.Any(), models.DefaultOfferOrder).
+					Times(1).
+					Return([]models.Offer{}, pagination.GetPaginationInfo(10, 0, next), nil)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{
+						Take:      10,
+						PageToken: (&models.PageToken{LastID: 10}).Encode(),
+						SkipCount: true,
+					},
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res.Pagination.HasNextPage).Should(BeTrue())
+				Expect(res.Pagination.NextPageToken).Should(Equal(next.Encode()))
+			})
+		})
+
+		When("filter is set", func() {
+			It("passes the filter to ListOffer", func() {
+				filter := models.OfferFilter{
+					UserIDs:        []uint64{1, 2},
+					TeamIDs:        []uint64{3},
+					GradeMin:       2,
+					GradeMax:       4,
+					IncludeDeleted: true,
+				}
+
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), models.PaginationInput{Take: 10}, filter, models.DefaultOfferOrder).
+					Times(1).
+					Return([]models.Offer{}, &models.PaginationInfo{Page: 1}, nil)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{Take: 10},
+					Filter: &pb.OfferFilter{
+						UserIds:        []uint64{1, 2},
+						TeamIds:        []uint64{3},
+						GradeMin:       2,
+						GradeMax:       4,
+						IncludeDeleted: true,
+					},
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res).ShouldNot(BeNil())
+			})
+		})
+
+		When("unknown error from ListOffer", func() {
+			It("returns an error", func() {
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, nil, errors.New(""))
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{
+						Take: 1,
+						Skip: 10,
+					},
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+				offers := make([]models.Offer, 0)
+
+				pagInfo := models.PaginationInfo{
+					Page:            1,
+					TotalPages:      1,
+					TotalItems:      0,
+					PerPage:         0,
+					HasNextPage:     false,
+					HasPreviousPage: false,
+				}
+
+				mRepo.EXPECT().
+					ListOffer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(offers, &pagInfo, nil)
+
+				req := &pb.ListOfferV1Request{
+					Pagination: &pb.PaginationInput{
+						Take: 1,
+						Skip: 10,
+					},
+				}
+
+				res, err := client.ListOfferV1(ctx, req)
+
+				Expect(res).ShouldNot(BeNil())
+				Expect(res.Pagination).Should(
+					BeEquivalentTo(&pb.PaginationInfo{
+						Page:            pagInfo.Page,
+						TotalPages:      pagInfo.TotalPages,
+						TotalItems:      pagInfo.TotalItems,
+						PerPage:         pagInfo.PerPage,
+						HasNextPage:     pagInfo.HasNextPage,
+						HasPreviousPage: pagInfo.HasPreviousPage,
+					}))
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("gRPC call to UpdateOfferV1 function", func() {
+		When("invalid arguments", func() {
+			It("initialized values returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					UpdateOffer(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.UpdateOfferV1Request{
+					Id:     0,
+					UserId: 0,
+					Grade:  0,
+					TeamId: 0,
+				}
+
+				res, err := client.UpdateOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("unknown error from RemoveOffer", func() {
+			It("returns an error", func() {
+				mRepo.EXPECT().
+					UpdateOffer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(errors.New(""))
+
+				req := &pb.UpdateOfferV1Request{
+					Id:     1,
+					UserId: 2,
+					Grade:  3,
+					TeamId: 4,
+				}
+
+				res, err := client.UpdateOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+
+				mRepo.EXPECT().
+					UpdateOffer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+
+				req := &pb.UpdateOfferV1Request{
+					Id:     1,
+					UserId: 2,
+					Grade:  3,
+					TeamId: 4,
+				}
+
+				res, err := client.UpdateOfferV1(ctx, req)
+
+				Expect(res).ShouldNot(BeNil())
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("stale expected version", func() {
+			It("returns an error codes.Aborted", func() {
+				mRepo.EXPECT().
+					UpdateOffer(gomock.Any(), models.Offer{ID: 1, UserID: 2, Grade: 3, TeamID: 4, Version: 5}).
+					Times(1).
+					Return(models.ErrOfferVersionMismatch)
+
+				req := &pb.UpdateOfferV1Request{
+					Id:              1,
+					UserId:          2,
+					Grade:           3,
+					TeamId:          4,
+					ExpectedVersion: 5,
+				}
+
+				res, err := client.UpdateOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Aborted))
+			})
+		})
+	})
+
+	Context("gRPC call to PatchOfferV1 function", func() {
+		When("invalid arguments", func() {
+			It("unknown path returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					PatchOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.PatchOfferV1Request{
+					Id:         1,
+					Offer:      &pb.Offer{Grade: 3},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"grade", "status"}},
+				}
+
+				res, err := client.PatchOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+
+			It("empty mask returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					PatchOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.PatchOfferV1Request{
+					Id:    1,
+					Offer: &pb.Offer{Grade: 3},
+				}
+
+				res, err := client.PatchOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+
+			It("invalid masked field returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					PatchOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.PatchOfferV1Request{
+					Id:         1,
+					Offer:      &pb.Offer{Grade: 3},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"grade", "team_id"}},
+				}
+
+				res, err := client.PatchOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("stale expected version", func() {
+			It("returns an error codes.Aborted", func() {
+				mRepo.EXPECT().
+					PatchOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(models.ErrOfferVersionMismatch)
+
+				req := &pb.PatchOfferV1Request{
+					Id:              1,
+					Offer:           &pb.Offer{Grade: 3},
+					UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"grade"}},
+					ExpectedVersion: 2,
+				}
+
+				res, err := client.PatchOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Aborted))
+			})
+		})
+
+		When("normal case", func() {
+			It("only masked fields are validated and passed", func() {
+				mRepo.EXPECT().
+					PatchOffer(gomock.Any(), models.Offer{ID: 1, Grade: 3}, []string{"grade"}).
+					Times(1).
+					Return(nil)
+
+				req := &pb.PatchOfferV1Request{
+					Id:         1,
+					Offer:      &pb.Offer{Grade: 3},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"grade"}},
+				}
+
+				res, err := client.PatchOfferV1(ctx, req)
+
+				Expect(res).ShouldNot(BeNil())
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("gRPC call to SendOfferV1 function", func() {
+		When("invalid arguments", func() {
+			It("req.Id = 0 returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					UpdateOfferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.SendOfferV1Request{Id: 0}
+				res, err := client.SendOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("the offer is already accepted", func() {
+			It("returns an error codes.FailedPrecondition", func() {
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&models.Offer{ID: 1, Status: models.OfferStatusAccepted}, nil)
+				mRepo.EXPECT().
+					UpdateOfferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.SendOfferV1Request{Id: 1}
+				res, err := client.SendOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.FailedPrecondition))
+			})
+		})
+
+		When("the status was changed concurrently", func() {
+			It("returns an error codes.FailedPrecondition", func() {
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&models.Offer{ID: 1, Status: models.OfferStatusDraft}, nil)
+				mRepo.EXPECT().
+					UpdateOfferStatus(gomock.Any(), uint64(1), models.OfferStatusDraft, models.OfferStatusSent).
+					Times(1).
+					Return(&repo.Error{Kind: repo.ErrConflict, Message: "conflict", Err: models.ErrInvalidStatusTransition})
+
+				req := &pb.SendOfferV1Request{Id: 1}
+				res, err := client.SendOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.FailedPrecondition))
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&models.Offer{ID: 1, Status: models.OfferStatusDraft}, nil)
+				mRepo.EXPECT().
+					UpdateOfferStatus(gomock.Any(), uint64(1), models.OfferStatusDraft, models.OfferStatusSent).
+					Times(1).
+					Return(nil)
+
+				req := &pb.SendOfferV1Request{Id: 1}
+				res, err := client.SendOfferV1(ctx, req)
+
+				Expect(res).ShouldNot(BeNil())
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("gRPC call to AcceptOfferV1 function", func() {
+		When("the offer is a draft", func() {
+			It("returns an error codes.FailedPrecondition", func() {
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&models.Offer{ID: 1, Status: models.OfferStatusDraft}, nil)
+
+				req := &pb.AcceptOfferV1Request{Id: 1}
+				res, err := client.AcceptOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.FailedPrecondition))
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&models.Offer{ID: 1, Status: models.OfferStatusSent}, nil)
+				mRepo.EXPECT().
+					UpdateOfferStatus(gomock.Any(), uint64(1), models.OfferStatusSent, models.OfferStatusAccepted).
+					Times(1).
+					Return(nil)
+
+				req := &pb.AcceptOfferV1Request{Id: 1}
+				res, err := client.AcceptOfferV1(ctx, req)
+
+				Expect(res).ShouldNot(BeNil())
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("gRPC call to DeclineOfferV1 function", func() {
+		When("normal case", func() {
+			It("all props corrected", func() {
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&models.Offer{ID: 1, Status: models.OfferStatusSent}, nil)
+				mRepo.EXPECT().
+					UpdateOfferStatus(gomock.Any(), uint64(1), models.OfferStatusSent, models.OfferStatusDeclined).
+					Times(1).
+					Return(nil)
+
+				req := &pb.DeclineOfferV1Request{Id: 1}
+				res, err := client.DeclineOfferV1(ctx, req)
+
+				Expect(res).ShouldNot(BeNil())
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("gRPC call to WithdrawOfferV1 function", func() {
+		When("the offer is already declined", func() {
+			It("returns an error codes.FailedPrecondition", func() {
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&models.Offer{ID: 1, Status: models.OfferStatusDeclined}, nil)
+
+				req := &pb.WithdrawOfferV1Request{Id: 1}
+				res, err := client.WithdrawOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.FailedPrecondition))
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+				mRepo.EXPECT().
+					DescribeOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&models.Offer{ID: 1, Status: models.OfferStatusDraft}, nil)
+				mRepo.EXPECT().
+					UpdateOfferStatus(gomock.Any(), uint64(1), models.OfferStatusDraft, models.OfferStatusWithdrawn).
+					Times(1).
+					Return(nil)
+
+				req := &pb.WithdrawOfferV1Request{Id: 1}
+				res, err := client.WithdrawOfferV1(ctx, req)
+
+				Expect(res).ShouldNot(BeNil())
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("gRPC call to RemoveOfferV1 function", func() {
+		When("invalid arguments", func() {
+			It("initialized values returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					RemoveOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.RemoveOfferV1Request{
+					Id: 0,
+				}
+
+				res, err := client.RemoveOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("unknown error from RemoveOffer", func() {
+			It("returns an error", func() {
+				mRepo.EXPECT().
+					RemoveOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(errors.New(""))
+
+				req := &pb.RemoveOfferV1Request{Id: 1}
+				res, err := client.RemoveOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
+			})
+		})
+
+		When("offer is not found or already removed", func() {
+			It("returns an error codes.NotFound", func() {
+				mRepo.EXPECT().
+					RemoveOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(&repo.Error{Kind: repo.ErrNotFound, Message: "offer 1 not found"})
+
+				req := &pb.RemoveOfferV1Request{Id: 1}
+				res, err := client.RemoveOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.NotFound))
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+
+				mRepo.EXPECT().
+					RemoveOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+
+				req := &pb.RemoveOfferV1Request{Id: 1}
+				res, err := client.RemoveOfferV1(ctx, req)
+
+				Expect(&res).ShouldNot(BeNil())
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("expected version in the If-Match metadata", func() {
+			It("passes the version to RemoveOffer", func() {
+				mRepo.EXPECT().
+					RemoveOffer(gomock.Any(), uint64(1), uint64(7)).
+					Times(1).
+					Return(nil)
+
+				mdCtx := metadata.AppendToOutgoingContext(ctx, api.IfMatchMetadataKey, `"7"`)
+
+				req := &pb.RemoveOfferV1Request{Id: 1}
+				res, err := client.RemoveOfferV1(mdCtx, req)
+
+				Expect(res).ShouldNot(BeNil())
+				Expect(err).Should(BeNil())
+			})
+
+			It("malformed version returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					RemoveOffer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				mdCtx := metadata.AppendToOutgoingContext(ctx, api.IfMatchMetadataKey, "abc")
+
+				req := &pb.RemoveOfferV1Request{Id: 1}
+				res, err := client.RemoveOfferV1(mdCtx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("stale expected version", func() {
+			It("returns an error codes.Aborted", func() {
+				mRepo.EXPECT().
+					RemoveOffer(gomock.Any(), uint64(1), uint64(2)).
+					Times(1).
+					Return(models.ErrOfferVersionMismatch)
+
+				req := &pb.RemoveOfferV1Request{Id: 1, ExpectedVersion: 2}
+				res, err := client.RemoveOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Aborted))
+			})
+		})
+	})
+
+	Context("gRPC call to RestoreOfferV1 function", func() {
+		When("invalid arguments", func() {
+			It("req.Id = 0 returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					RestoreOffer(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.RestoreOfferV1Request{Id: 0}
+				res, err := client.RestoreOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("offer is not removed", func() {
+			It("returns an error codes.FailedPrecondition", func() {
+				mRepo.EXPECT().
+					RestoreOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&repo.Error{Kind: repo.ErrConflict, Message: "conflict", Err: models.ErrOfferNotRemoved})
+
+				req := &pb.RestoreOfferV1Request{Id: 1}
+				res, err := client.RestoreOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.FailedPrecondition))
+			})
+		})
+
+		When("offer was issued again after the removal", func() {
+			It("returns an error codes.AlreadyExists", func() {
+				mRepo.EXPECT().
+					RestoreOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(&repo.Error{Kind: repo.ErrAlreadyExists, Message: "already exists"})
+
+				req := &pb.RestoreOfferV1Request{Id: 1}
+				res, err := client.RestoreOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.AlreadyExists))
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+				mRepo.EXPECT().
+					RestoreOffer(gomock.Any(), uint64(1)).
+					Times(1).
+					Return(nil)
+
+				req := &pb.RestoreOfferV1Request{Id: 1}
+				res, err := client.RestoreOfferV1(ctx, req)
+
+				Expect(res).ShouldNot(BeNil())
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("gRPC call to PurgeOffersV1 function", func() {
+		When("invalid arguments", func() {
+			It("deleted_before in the future returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					PurgeOffers(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.PurgeOffersV1Request{
+					DeletedBefore: timestamppb.New(time.Now().Add(time.Hour)),
+				}
+				res, err := client.PurgeOffersV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+				deletedBefore := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+				mRepo.EXPECT().
+					PurgeOffers(gomock.Any(), deletedBefore).
+					Times(1).
+					Return(uint64(3), nil)
+
+				req := &pb.PurgeOffersV1Request{
+					DeletedBefore: timestamppb.New(deletedBefore),
+				}
+				res, err := client.PurgeOffersV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res.Count).Should(BeEquivalentTo(3))
+			})
+		})
+	})
+
+	Context("gRPC call to TaskMultiCreateOfferV1 function", func() {
+		When("error from the producer", func() {
+			It("fails the task and returns an error codes.Internal", func() {
+				publishErr := errors.New("broker is not available")
+
+				mRepo.EXPECT().
+					CreateTask(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+
+				mProducer.EXPECT().
+					MultiCreateOffers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(publishErr)
+
+				mRepo.EXPECT().
+					FinishTaskPart(gomock.Any(), gomock.Any(), gomock.Nil(), publishErr).
+					Times(1).
+					Return(nil)
+
+				req := &pb.TaskMultiCreateOfferV1Request{
+					Offers:    []*pb.CreateOfferV1Request{{UserId: 1, Grade: 2, TeamId: 3}},
+					BatchSize: 1,
+				}
+				res, err := client.TaskMultiCreateOfferV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.Internal))
+			})
+		})
+
+		When("normal case", func() {
+			It("publishes a message per batch with the id of the task", func() {
+				var task *models.Task
+
+				mRepo.EXPECT().
+					CreateTask(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, t *models.Task) error {
+						task = t
+
+						return nil
+					})
+
+				mProducer.EXPECT().
+					MultiCreateOffers(gomock.Any(), gomock.Len(2)).
+					Times(1).
+					Return(nil)
+
+				req := &pb.TaskMultiCreateOfferV1Request{
+					Offers: []*pb.CreateOfferV1Request{
+						{UserId: 1, Grade: 2, TeamId: 3},
+						{UserId: 2, Grade: 2, TeamId: 3},
+						{UserId: 3, Grade: 2, TeamId: 3},
+					},
+					BatchSize: 2,
+				}
+				res, err := client.TaskMultiCreateOfferV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res.TaskId).Should(Equal(task.ID))
+				Expect(task.Type).Should(Equal(models.TaskTypeMultiCreateOffer))
+				Expect(task.Status).Should(Equal(models.TaskStatusQueued))
+				Expect(task.Parts).Should(BeEquivalentTo(2))
+			})
+		})
+	})
+
+	Context("gRPC call to TaskUpdateOfferV1 function", func() {
+		When("normal case", func() {
+			It("publishes the update command", func() {
+				mRepo.EXPECT().
+					CreateTask(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+
+				mProducer.EXPECT().
+					UpdateOffer(gomock.Any(), models.Offer{ID: 1, UserID: 2, Grade: 3, TeamID: 4}).
+					Times(1).
+					Return(nil)
+
+				req := &pb.TaskUpdateOfferV1Request{Id: 1, UserId: 2, Grade: 3, TeamId: 4}
+				res, err := client.TaskUpdateOfferV1(ctx, req)
+
+				Expect(err).Should(BeNil())
+				Expect(res.TaskId).ShouldNot(BeEmpty())
+			})
+		})
+	})
+
+	Context("gRPC call to GetTaskStatusV1 function", func() {
+		When("invalid arguments", func() {
+			It("malformed id returns an error codes.InvalidArgument", func() {
+				mRepo.EXPECT().
+					GetTask(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				req := &pb.GetTaskStatusV1Request{Id: "task"}
+				res, err := client.GetTaskStatusV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.InvalidArgument))
+			})
+		})
+
+		When("task not found", func() {
+			It("returns an error codes.NotFound", func() {
+				mRepo.EXPECT().
+					GetTask(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, &repo.Error{Kind: repo.ErrNotFound, Message: "not found"})
+
+				req := &pb.GetTaskStatusV1Request{Id: "9b2e2a4c-5f0b-4d7e-8a51-3f6c1d2e7b90"}
+				res, err := client.GetTaskStatusV1(ctx, req)
+
+				Expect(res).Should(BeNil())
+				Expect(status.Code(err)).Should(BeEquivalentTo(codes.NotFound))
+			})
+		})
+
+		When("normal case", func() {
+			It("all props corrected", func() {
+				taskID := "9b2e2a4c-5f0b-4d7e-8a51-3f6c1d2e7b90"
+
+				mRepo.EXPECT().
+					GetTask(gomock.Any(), taskID).
+					Times(1).
+					Return(&models.Task{
+						ID:        taskID,
+						Type:      models.TaskTypeMultiCreateOffer,
+						Status:    models.TaskStatusFailed,
+						Reason:    "already exists",
+						OfferIDs:  []uint64{1, 2},
+						Parts:     2,
+						PartsDone: 2,
+					}, nil)
+
+				req := &pb.GetTaskStatusV1Request{Id: taskID}
+				res, err := client.GetTaskStatusV1(ctx, req)
 
-				Expect(&res).ShouldNot(BeNil())
 				Expect(err).Should(BeNil())
+				Expect(res.Task.Id).Should(Equal(taskID))
+				Expect(res.Task.Type).Should(Equal(pb.TaskType_TASK_TYPE_MULTI_CREATE_OFFER))
+				Expect(res.Task.Status).Should(Equal(pb.TaskStatus_TASK_STATUS_FAILED))
+				Expect(res.Task.Reason).Should(Equal("already exists"))
+				Expect(res.Task.OfferIds).Should(Equal([]uint64{1, 2}))
 			})
 		})
 	})
@@ -30,6 +30,10 @@ var (
 	Metrics  *metrics
 	Kafka    *kafka
 	Status   *status
+	Expiry   *expiry
+	Purge    *purge
+	Outbox   *outbox
+	Consumer *consumer
 )
 
 // config - microservice config.
@@ -41,6 +45,10 @@ type config struct {
 	Database database `yaml:"database"`
 	Kafka    kafka    `yaml:"kafka"`
 	Status   status   `yaml:"status"`
+	Expiry   expiry   `yaml:"expiry"`
+	Purge    purge    `yaml:"purge"`
+	Outbox   outbox   `yaml:"outbox"`
+	Consumer consumer `yaml:"consumer"`
 }
 
 // gRPC config.
@@ -86,10 +94,11 @@ type database struct {
 
 // Kafka config.
 type kafka struct {
-	Capacity uint64   `yaml:"capacity"`
-	Topic    string   `yaml:"topic"`
-	GroupID  string   `yaml:"groupId"`
-	Brokers  []string `yaml:"brokers"`
+	Topic           string   `yaml:"topic"`
+	EventsTopic     string   `yaml:"eventsTopic"`
+	DeadLetterTopic string   `yaml:"deadLetterTopic"`
+	GroupID         string   `yaml:"groupId"`
+	Brokers         []string `yaml:"brokers"`
 }
 
 // Service status config.
@@ -101,7 +110,93 @@ type status struct {
 	ReadinessPath string `yaml:"readinessPath" env:"STATUS_READINESS_PATH"`
 }
 
+// Offer expiry worker config.
+type expiry struct {
+	Interval  int64  `yaml:"interval" env:"EXPIRY_INTERVAL"`
+	BatchSize uint64 `yaml:"batchSize" env:"EXPIRY_BATCH_SIZE"`
+}
+
+// Purge of removed offers config.
+type purge struct {
+	Retention int64 `yaml:"retention" env:"PURGE_RETENTION"`
+	Interval  int64 `yaml:"interval" env:"PURGE_INTERVAL"`
+}
+
+// Outbox relay config.
+type outbox struct {
+	Interval   int64  `yaml:"interval" env:"OUTBOX_INTERVAL"`
+	MaxBackoff int64  `yaml:"maxBackoff" env:"OUTBOX_MAX_BACKOFF"`
+	BatchSize  uint64 `yaml:"batchSize" env:"OUTBOX_BATCH_SIZE"`
+}
+
+// Kafka consumer retry policy config.
+type consumer struct {
+	MaxAttempts    int   `yaml:"maxAttempts" env:"CONSUMER_MAX_ATTEMPTS"`
+	InitialBackoff int64 `yaml:"initialBackoff" env:"CONSUMER_INITIAL_BACKOFF"`
+	MaxBackoff     int64 `yaml:"maxBackoff" env:"CONSUMER_MAX_BACKOFF"`
+}
+
 var fileConfig = "config.yml"
+
+// defaultConfig - values of the settings missing in the config file.
+func defaultConfig() *config {
+	return &config{
+		Expiry:   expiry{Interval: 60, BatchSize: 500},
+		Purge:    purge{Retention: 720, Interval: 60},
+		Outbox:   outbox{Interval: 1, MaxBackoff: 60, BatchSize: 100},
+		Consumer: consumer{MaxAttempts: 5, InitialBackoff: 200, MaxBackoff: 10000},
+	}
+}
+
+// validate rejects the settings the workers can't run with.
+func (c *config) validate() error {
+	if c.Expiry.Interval <= 0 {
+		return fmt.Errorf("expiry.interval must be positive, got %d", c.Expiry.Interval)
+	}
+
+	if c.Expiry.BatchSize == 0 {
+		return fmt.Errorf("expiry.batchSize must be positive")
+	}
+
+	if c.Purge.Retention <= 0 {
+		return fmt.Errorf("purge.retention must be positive, got %d", c.Purge.Retention)
+	}
+
+	if c.Purge.Interval <= 0 {
+		return fmt.Errorf("purge.interval must be positive, got %d", c.Purge.Interval)
+	}
+
+	if c.Outbox.Interval <= 0 {
+		return fmt.Errorf("outbox.interval must be positive, got %d", c.Outbox.Interval)
+	}
+
+	if c.Outbox.MaxBackoff < c.Outbox.Interval {
+		return fmt.Errorf("outbox.maxBackoff must not be less than outbox.interval, got %d", c.Outbox.MaxBackoff)
+	}
+
+	if c.Outbox.BatchSize == 0 {
+		return fmt.Errorf("outbox.batchSize must be positive")
+	}
+
+	if c.Consumer.MaxAttempts < 1 {
+		return fmt.Errorf("consumer.maxAttempts must be positive, got %d", c.Consumer.MaxAttempts)
+	}
+
+	if c.Consumer.InitialBackoff <= 0 {
+		return fmt.Errorf("consumer.initialBackoff must be positive, got %d", c.Consumer.InitialBackoff)
+	}
+
+	if c.Consumer.MaxBackoff < c.Consumer.InitialBackoff {
+		return fmt.Errorf("consumer.maxBackoff must not be less than consumer.initialBackoff, got %d", c.Consumer.MaxBackoff)
+	}
+
+	if c.Kafka.DeadLetterTopic == "" {
+		return fmt.Errorf("kafka.deadLetterTopic must be set")
+	}
+
+	return nil
+}
+
 var doOnce sync.Once
 
 func init() {
@@ -140,13 +235,26 @@ func UpdateConfig() error {
 	}
 	defer file.Close()
 
+	newCfg := defaultConfig()
+
 	decoder := yaml.NewDecoder(file)
-	if err := decoder.Decode(&cfg); err != nil {
+	if err := decoder.Decode(newCfg); err != nil {
 		return err
 	}
 
 	// read environment and replace
-	readEnvAndSet(reflect.ValueOf(cfg))
+	readEnvAndSet(reflect.ValueOf(newCfg))
+
+	if err := newCfg.validate(); err != nil {
+		return err
+	}
+
+	// The sections are referenced by the global values, so the reloaded config replaces them in place
+	if cfg == nil {
+		cfg = newCfg
+	} else {
+		*cfg = *newCfg
+	}
 
 	// Set global value
 	Project = &cfg.Project
@@ -159,6 +267,10 @@ func UpdateConfig() error {
 	Database = &cfg.Database
 	Kafka = &cfg.Kafka
 	Status = &cfg.Status
+	Expiry = &cfg.Expiry
+	Purge = &cfg.Purge
+	Outbox = &cfg.Outbox
+	Consumer = &cfg.Consumer
 
 	return nil
 }
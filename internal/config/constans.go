@@ -32,4 +32,12 @@ const (
 	DatabaseName     = "DATABASE_NAME"
 	DatabaseSslMode  = "DATABASE_SSL_MODE"
 	DatabaseDriver   = "DATABASE_DRIVER"
+
+	// Expiry environment constants.
+	ExpiryInterval  = "EXPIRY_INTERVAL"
+	ExpiryBatchSize = "EXPIRY_BATCH_SIZE"
+
+	// Purge environment constants.
+	PurgeRetention = "PURGE_RETENTION"
+	PurgeInterval  = "PURGE_INTERVAL"
 )
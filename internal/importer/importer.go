@@ -0,0 +1,347 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
+)
+
+// Format of the imported file.
+type Format string
+
+const (
+	// FormatCSV - comma separated values with a header row, the columns are
+	// user_id, team_id, grade and the optional expires_at in RFC 3339.
+	FormatCSV Format = "csv"
+	// FormatJSONL - one JSON object per line in the format of CreateOfferV1Request.
+	FormatJSONL Format = "jsonl"
+)
+
+// createChunkSize - number of offers per MultiCreateOfferV1 request,
+// keeps the requests of big files under the gRPC message size limit.
+const createChunkSize = 1000
+
+// ErrUnknownFormat - the format is neither csv nor jsonl.
+var ErrUnknownFormat = errors.New("unknown import format")
+
+// ParseFormat parses the format name, "ndjson" is accepted as an alias of jsonl.
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "csv":
+		return FormatCSV, nil
+	case "jsonl", "ndjson":
+		return FormatJSONL, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+	}
+}
+
+// RowStatus - result of the import of a single row.
+type RowStatus string
+
+const (
+	RowStatusCreated RowStatus = "created"
+	// The row is blank or repeats the user and team of an earlier row
+	RowStatusSkipped RowStatus = "skipped"
+	RowStatusFailed  RowStatus = "failed"
+	// The row is valid, but nothing is written in the dry-run mode
+	RowStatusValid RowStatus = "valid"
+)
+
+// RowResult - result of the import of the row with the given number.
+// Rows are numbered from 1, the CSV header is not counted.
+type RowResult struct {
+	Row    int       `json:"row"`
+	Status RowStatus `json:"status"`
+	Error  string    `json:"error,omitempty"`
+}
+
+// Report - results of all rows of the file.
+type Report struct {
+	DryRun  bool        `json:"dry_run"`
+	Created int         `json:"created"`
+	Skipped int         `json:"skipped"`
+	Failed  int         `json:"failed"`
+	Rows    []RowResult `json:"rows"`
+}
+
+func (r *Report) add(row int, rowStatus RowStatus, err error) {
+	result := RowResult{Row: row, Status: rowStatus}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	switch rowStatus {
+	case RowStatusCreated:
+		r.Created++
+	case RowStatusSkipped:
+		r.Skipped++
+	case RowStatusFailed:
+		r.Failed++
+	}
+
+	r.Rows = append(r.Rows, result)
+}
+
+// CreateFunc creates the offers like MultiCreateOfferV1 of the gRPC client or of the service itself.
+type CreateFunc func(ctx context.Context, req *pb.MultiCreateOfferV1Request) (*pb.MultiCreateOfferV1Response, error)
+
+// Importer validates offers from CSV and JSONL files and creates them with MultiCreateOfferV1,
+// so the imported offers get the same checks, history and events as the created ones.
+type Importer struct {
+	create CreateFunc
+}
+
+func NewImporter(create CreateFunc) *Importer {
+	return &Importer{create: create}
+}
+
+type parsedRow struct {
+	row int
+	req *pb.CreateOfferV1Request
+	err error
+}
+
+// Import reads the whole file, validates every row and creates the valid offers.
+// Only a malformed file makes an error, problems of single rows are listed in the report.
+// In the dry-run mode the rows are only validated.
+func (i *Importer) Import(ctx context.Context, input io.Reader, format Format, dryRun bool) (*Report, error) {
+	var (
+		rows []parsedRow
+		err  error
+	)
+
+	switch format {
+	case FormatCSV:
+		rows, err = parseCSV(input)
+	case FormatJSONL:
+		rows, err = parseJSONL(input)
+	default:
+		err = fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{DryRun: dryRun, Rows: make([]RowResult, 0, len(rows))}
+
+	type userTeam struct{ userID, teamID uint64 }
+
+	seen := make(map[userTeam]int)
+	offers := make([]*pb.CreateOfferV1Request, 0, len(rows))
+	offerRows := make([]int, 0, len(rows))
+
+	for _, row := range rows {
+		switch {
+		case row.err != nil:
+			report.add(row.row, RowStatusFailed, row.err)
+
+			continue
+		case row.req == nil:
+			report.add(row.row, RowStatusSkipped, nil)
+
+			continue
+		}
+
+		if err := row.req.Validate(); err != nil {
+			report.add(row.row, RowStatusFailed, err)
+
+			continue
+		}
+
+		key := userTeam{row.req.UserId, row.req.TeamId}
+		if first, ok := seen[key]; ok {
+			report.add(row.row, RowStatusSkipped, fmt.Errorf("duplicate of row %d", first))
+
+			continue
+		}
+		seen[key] = row.row
+
+		offers = append(offers, row.req)
+		offerRows = append(offerRows, row.row)
+	}
+
+	if dryRun {
+		for _, row := range offerRows {
+			report.add(row, RowStatusValid, nil)
+		}
+	} else {
+		for start := 0; start < len(offers); start += createChunkSize {
+			end := start + createChunkSize
+			if end > len(offers) {
+				end = len(offers)
+			}
+
+			i.createChunk(ctx, report, offers[start:end], offerRows[start:end])
+		}
+	}
+
+	sort.Slice(report.Rows, func(a, b int) bool {
+		return report.Rows[a].Row < report.Rows[b].Row
+	})
+
+	return report, nil
+}
+
+// createChunk creates the offers of the rows and adds the results to the report.
+// A row failed by the service doesn't stop the others, all rows of the chunk fail only with the request.
+func (i *Importer) createChunk(ctx context.Context, report *Report, offers []*pb.CreateOfferV1Request, rows []int) {
+	res, err := i.create(ctx, &pb.MultiCreateOfferV1Request{
+		Offers:         offers,
+		BestEffort:     true,
+		ConflictPolicy: pb.ConflictPolicy_CONFLICT_POLICY_FAIL,
+	})
+
+	if err != nil {
+		for _, row := range rows {
+			report.add(row, RowStatusFailed, errors.New(status.Convert(err).Message()))
+		}
+
+		return
+	}
+
+	failed := make(map[uint32]error, len(res.Errors))
+	for _, offerErr := range res.Errors {
+		failed[offerErr.Index] = errors.New(offerErr.Message)
+	}
+
+	for index, row := range rows {
+		if err, ok := failed[uint32(index)]; ok {
+			report.add(row, RowStatusFailed, err)
+		} else {
+			report.add(row, RowStatusCreated, nil)
+		}
+	}
+}
+
+// parseCSV parses rows by the header, the order of the columns doesn't matter.
+func parseCSV(input io.Reader) ([]parsedRow, error) {
+	reader := csv.NewReader(input)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for index, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = index
+	}
+
+	for _, name := range []string{"user_id", "team_id", "grade"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("the header has no %q column", name)
+		}
+	}
+
+	// Rows are compared by the number of fields with the header
+	reader.FieldsPerRecord = len(header)
+
+	rows := make([]parsedRow, 0)
+
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+			rows = append(rows, parsedRow{row: row, err: parseErr.Err})
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := csvRecordToRequest(record, columns)
+		rows = append(rows, parsedRow{row: row, req: req, err: err})
+	}
+}
+
+func csvRecordToRequest(record []string, columns map[string]int) (*pb.CreateOfferV1Request, error) {
+	field := func(name string) string {
+		if index, ok := columns[name]; ok {
+			return strings.TrimSpace(record[index])
+		}
+
+		return ""
+	}
+
+	req := &pb.CreateOfferV1Request{}
+
+	numbers := []struct {
+		name  string
+		value *uint64
+	}{
+		{name: "user_id", value: &req.UserId},
+		{name: "team_id", value: &req.TeamId},
+		{name: "grade", value: &req.Grade},
+	}
+
+	for _, number := range numbers {
+		value, err := strconv.ParseUint(field(number.name), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", number.name, err)
+		}
+
+		*number.value = value
+	}
+
+	if expiresAt := field("expires_at"); expiresAt != "" {
+		moment, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expires_at: %w", err)
+		}
+
+		req.ExpiresAt = timestamppb.New(moment)
+	}
+
+	return req, nil
+}
+
+// parseJSONL parses every line as CreateOfferV1Request, blank lines are skipped rows.
+func parseJSONL(input io.Reader) ([]parsedRow, error) {
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	rows := make([]parsedRow, 0)
+
+	for row := 1; scanner.Scan(); row++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			rows = append(rows, parsedRow{row: row})
+
+			continue
+		}
+
+		req := &pb.CreateOfferV1Request{}
+		if err := protojson.Unmarshal(line, req); err != nil {
+			rows = append(rows, parsedRow{row: row, err: err})
+
+			continue
+		}
+
+		rows = append(rows, parsedRow{row: row, req: req})
+	}
+
+	return rows, scanner.Err()
+}
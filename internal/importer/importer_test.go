@@ -0,0 +1,192 @@
+package importer_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ozoncp/ocp-offer-api/internal/importer"
+	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
+)
+
+func TestImporterImport(t *testing.T) {
+	t.Parallel()
+
+	csvFile := "grade,user_id,team_id\n" +
+		"1,10,100\n" +
+		"0,11,100\n" +
+		"x,12,100\n" +
+		"2,10,100\n" +
+		"3,13,101\n"
+
+	jsonlFile := `{"user_id": 10, "team_id": 100, "grade": 1}` + "\n" +
+		"\n" +
+		`{"user_id": "bad"}` + "\n" +
+		`{"userId": 13, "teamId": 101, "grade": 3}` + "\n"
+
+	valid := &pb.MultiCreateOfferV1Request{
+		Offers: []*pb.CreateOfferV1Request{
+			{UserId: 10, TeamId: 100, Grade: 1},
+			{UserId: 13, TeamId: 101, Grade: 3},
+		},
+		BestEffort:     true,
+		ConflictPolicy: pb.ConflictPolicy_CONFLICT_POLICY_FAIL,
+	}
+
+	testCases := []struct {
+		name     string                         // Название теста
+		file     string                         // Импортируемый файл
+		format   importer.Format                // Формат файла
+		dryRun   bool                           // Только проверка
+		res      *pb.MultiCreateOfferV1Response // Ответ MultiCreateOfferV1
+		err      error                          // Ошибка MultiCreateOfferV1
+		statuses map[int]importer.RowStatus     // Ожидаемые статусы строк
+		isError  bool                           // Если должна вернуться ошибка
+		calls    int                            // Количество вызовов MultiCreateOfferV1
+	}{
+		{
+			name:   "CSV",
+			file:   csvFile,
+			format: importer.FormatCSV,
+			res:    &pb.MultiCreateOfferV1Response{Count: 2, Ids: []uint64{1, 2}},
+			calls:  1,
+			statuses: map[int]importer.RowStatus{
+				1: importer.RowStatusCreated,
+				2: importer.RowStatusFailed,
+				3: importer.RowStatusFailed,
+				4: importer.RowStatusSkipped,
+				5: importer.RowStatusCreated,
+			},
+		},
+		{
+			name:   "JSONL",
+			file:   jsonlFile,
+			format: importer.FormatJSONL,
+			res:    &pb.MultiCreateOfferV1Response{Count: 2, Ids: []uint64{1, 2}},
+			calls:  1,
+			statuses: map[int]importer.RowStatus{
+				1: importer.RowStatusCreated,
+				2: importer.RowStatusSkipped,
+				3: importer.RowStatusFailed,
+				4: importer.RowStatusCreated,
+			},
+		},
+		{
+			name:   "Dry run",
+			file:   csvFile,
+			format: importer.FormatCSV,
+			dryRun: true,
+			statuses: map[int]importer.RowStatus{
+				1: importer.RowStatusValid,
+				2: importer.RowStatusFailed,
+				3: importer.RowStatusFailed,
+				4: importer.RowStatusSkipped,
+				5: importer.RowStatusValid,
+			},
+		},
+		{
+			name:   "Row failed by the service",
+			file:   jsonlFile,
+			format: importer.FormatJSONL,
+			res: &pb.MultiCreateOfferV1Response{
+				Count:  1,
+				Ids:    []uint64{1, 0},
+				Errors: []*pb.OfferError{{Index: 1, Code: codes.AlreadyExists.String(), Message: "already exists"}},
+			},
+			calls: 1,
+			statuses: map[int]importer.RowStatus{
+				1: importer.RowStatusCreated,
+				2: importer.RowStatusSkipped,
+				3: importer.RowStatusFailed,
+				4: importer.RowStatusFailed,
+			},
+		},
+		{
+			name:   "Failed request",
+			file:   jsonlFile,
+			format: importer.FormatJSONL,
+			err:    status.Error(codes.Unavailable, "connection lost"),
+			calls:  1,
+			statuses: map[int]importer.RowStatus{
+				1: importer.RowStatusFailed,
+				2: importer.RowStatusSkipped,
+				3: importer.RowStatusFailed,
+				4: importer.RowStatusFailed,
+			},
+		},
+		{
+			name:    "CSV without a required column",
+			file:    "user_id,grade\n1,1\n",
+			format:  importer.FormatCSV,
+			isError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			create := func(ctx context.Context, req *pb.MultiCreateOfferV1Request) (*pb.MultiCreateOfferV1Response, error) {
+				calls++
+				assert.True(t, proto.Equal(valid, req), "unexpected request %v", req)
+
+				return tc.res, tc.err
+			}
+
+			report, err := importer.NewImporter(create).Import(context.Background(), strings.NewReader(tc.file), tc.format, tc.dryRun)
+			assert.Equal(t, tc.calls, calls)
+			if tc.isError {
+				assert.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			statuses := make(map[int]importer.RowStatus, len(report.Rows))
+			for _, row := range report.Rows {
+				statuses[row.Row] = row.Status
+			}
+			assert.Equal(t, tc.statuses, statuses)
+			assert.Equal(t, tc.dryRun, report.DryRun)
+		})
+	}
+}
+
+func TestImporterImportChunks(t *testing.T) {
+	t.Parallel()
+
+	var file strings.Builder
+	file.WriteString("user_id,team_id,grade\n")
+
+	const rows = 2500
+	for userID := 1; userID <= rows; userID++ {
+		fmt.Fprintf(&file, "%d,1,1\n", userID)
+	}
+
+	var sizes []int
+	create := func(ctx context.Context, req *pb.MultiCreateOfferV1Request) (*pb.MultiCreateOfferV1Response, error) {
+		sizes = append(sizes, len(req.Offers))
+
+		// The first offer of every request fails
+		return &pb.MultiCreateOfferV1Response{
+			Errors: []*pb.OfferError{{Index: 0, Code: codes.AlreadyExists.String(), Message: "already exists"}},
+		}, nil
+	}
+
+	report, err := importer.NewImporter(create).Import(context.Background(), strings.NewReader(file.String()), importer.FormatCSV, false)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1000, 1000, 500}, sizes)
+	assert.Equal(t, rows-3, report.Created)
+	assert.Equal(t, 3, report.Failed)
+	assert.Equal(t, importer.RowStatusFailed, report.Rows[1000].Status)
+	assert.Equal(t, "already exists", report.Rows[1000].Error)
+}
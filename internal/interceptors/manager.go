@@ -8,8 +8,15 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
 )
 
+// ActorMetadataKey - metadata key with the author of the changes.
+// The gateway fills it from the `X-Actor` HTTP header.
+const ActorMetadataKey = "x-actor"
+
 var (
 	totalRequests = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "grpc_microservice_requests_total",
@@ -45,3 +52,20 @@ func (im *InterceptorManager) Logger(
 
 	return reply, err
 }
+
+// Actor Interceptor puts the author of the changes from the metadata into the context.
+func (im *InterceptorManager) Actor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(ActorMetadataKey); len(values) > 0 {
+			ctx = models.ContextWithActor(ctx, values[0])
+		}
+	}
+
+	return handler(ctx, req)
+}
@@ -35,37 +35,39 @@ func (m *MockIProducer) EXPECT() *MockIProducerMockRecorder {
 }
 
 // CreateOffer mocks base method.
-func (m *MockIProducer) CreateOffer(arg0 models.Offer) error {
+func (m *MockIProducer) CreateOffer(arg0 string, arg1 models.Offer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOffer", arg0)
+	ret := m.ctrl.Call(m, "CreateOffer", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateOffer indicates an expected call of CreateOffer.
-func (mr *MockIProducerMockRecorder) CreateOffer(arg0 interface{}) *gomock.Call {
+func (mr *MockIProducerMockRecorder) CreateOffer(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOffer", reflect.TypeOf((*MockIProducer)(nil).CreateOffer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOffer", reflect.TypeOf((*MockIProducer)(nil).CreateOffer), arg0, arg1)
 }
 
 // DeleteOffer mocks base method.
-func (m *MockIProducer) DeleteOffer(arg0 uint64) error {
+func (m *MockIProducer) DeleteOffer(arg0 string, arg1 uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOffer", arg0)
+	ret := m.ctrl.Call(m, "DeleteOffer", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteOffer indicates an expected call of DeleteOffer.
-func (mr *MockIProducerMockRecorder) DeleteOffer(arg0 interface{}) *gomock.Call {
+func (mr *MockIProducerMockRecorder) DeleteOffer(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOffer", reflect.TypeOf((*MockIProducer)(nil).DeleteOffer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOffer", reflect.TypeOf((*MockIProducer)(nil).DeleteOffer), arg0, arg1)
 }
 
 // MultiCreateOffers mocks base method.
-func (m *MockIProducer) MultiCreateOffers(arg0 []models.Offer, arg1 uint64) {
+func (m *MockIProducer) MultiCreateOffers(arg0 string, arg1 [][]models.Offer) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "MultiCreateOffers", arg0, arg1)
+	ret := m.ctrl.Call(m, "MultiCreateOffers", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // MultiCreateOffers indicates an expected call of MultiCreateOffers.
@@ -75,15 +77,15 @@ func (mr *MockIProducerMockRecorder) MultiCreateOffers(arg0, arg1 interface{}) *
 }
 
 // UpdateOffer mocks base method.
-func (m *MockIProducer) UpdateOffer(arg0 models.Offer) error {
+func (m *MockIProducer) UpdateOffer(arg0 string, arg1 models.Offer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOffer", arg0)
+	ret := m.ctrl.Call(m, "UpdateOffer", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateOffer indicates an expected call of UpdateOffer.
-func (mr *MockIProducerMockRecorder) UpdateOffer(arg0 interface{}) *gomock.Call {
+func (mr *MockIProducerMockRecorder) UpdateOffer(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOffer", reflect.TypeOf((*MockIProducer)(nil).UpdateOffer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOffer", reflect.TypeOf((*MockIProducer)(nil).UpdateOffer), arg0, arg1)
 }
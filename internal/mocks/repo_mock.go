@@ -7,9 +7,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	models "github.com/ozoncp/ocp-offer-api/internal/models"
+	repo "github.com/ozoncp/ocp-offer-api/internal/repo"
 )
 
 // MockIRepository is a mock of IRepository interface.
@@ -35,19 +37,48 @@ func (m *MockIRepository) EXPECT() *MockIRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BatchGetOffers mocks base method.
+func (m *MockIRepository) BatchGetOffers(arg0 context.Context, arg1 []uint64) ([]models.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetOffers", arg0, arg1)
+	ret0, _ := ret[0].([]models.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetOffers indicates an expected call of BatchGetOffers.
+func (mr *MockIRepositoryMockRecorder) BatchGetOffers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetOffers", reflect.TypeOf((*MockIRepository)(nil).BatchGetOffers), arg0, arg1)
+}
+
 // CreateOffer mocks base method.
-func (m *MockIRepository) CreateOffer(arg0 context.Context, arg1 models.Offer) (uint64, error) {
+func (m *MockIRepository) CreateOffer(arg0 context.Context, arg1 models.Offer, arg2 models.ConflictPolicy) (models.CreateResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOffer", arg0, arg1)
-	ret0, _ := ret[0].(uint64)
+	ret := m.ctrl.Call(m, "CreateOffer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.CreateResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateOffer indicates an expected call of CreateOffer.
-func (mr *MockIRepositoryMockRecorder) CreateOffer(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIRepositoryMockRecorder) CreateOffer(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOffer", reflect.TypeOf((*MockIRepository)(nil).CreateOffer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOffer", reflect.TypeOf((*MockIRepository)(nil).CreateOffer), arg0, arg1, arg2)
+}
+
+// CreateTask mocks base method.
+func (m *MockIRepository) CreateTask(arg0 context.Context, arg1 *models.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockIRepositoryMockRecorder) CreateTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockIRepository)(nil).CreateTask), arg0, arg1)
 }
 
 // DescribeOffer mocks base method.
@@ -65,10 +96,128 @@ func (mr *MockIRepositoryMockRecorder) DescribeOffer(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOffer", reflect.TypeOf((*MockIRepository)(nil).DescribeOffer), arg0, arg1)
 }
 
+// ExpireOffers mocks base method.
+func (m *MockIRepository) ExpireOffers(arg0 context.Context, arg1 time.Time, arg2 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOffers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOffers indicates an expected call of ExpireOffers.
+func (mr *MockIRepositoryMockRecorder) ExpireOffers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOffers", reflect.TypeOf((*MockIRepository)(nil).ExpireOffers), arg0, arg1, arg2)
+}
+
+// ExportOffers mocks base method.
+func (m *MockIRepository) ExportOffers(arg0 context.Context, arg1 models.OfferFilter, arg2 func(*models.Offer) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOffers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportOffers indicates an expected call of ExportOffers.
+func (mr *MockIRepositoryMockRecorder) ExportOffers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOffers", reflect.TypeOf((*MockIRepository)(nil).ExportOffers), arg0, arg1, arg2)
+}
+
+// FinishTaskPart mocks base method.
+func (m *MockIRepository) FinishTaskPart(arg0 context.Context, arg1 string, arg2 []uint64, arg3 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishTaskPart", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishTaskPart indicates an expected call of FinishTaskPart.
+func (mr *MockIRepositoryMockRecorder) FinishTaskPart(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishTaskPart", reflect.TypeOf((*MockIRepository)(nil).FinishTaskPart), arg0, arg1, arg2, arg3)
+}
+
+// GetOfferHistory mocks base method.
+func (m *MockIRepository) GetOfferHistory(arg0 context.Context, arg1 uint64) ([]models.OfferRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfferHistory", arg0, arg1)
+	ret0, _ := ret[0].([]models.OfferRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfferHistory indicates an expected call of GetOfferHistory.
+func (mr *MockIRepositoryMockRecorder) GetOfferHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfferHistory", reflect.TypeOf((*MockIRepository)(nil).GetOfferHistory), arg0, arg1)
+}
+
+// GetOfferStats mocks base method.
+func (m *MockIRepository) GetOfferStats(arg0 context.Context, arg1 []models.OfferStatsDimension, arg2 models.OfferStatsFilter) ([]models.OfferStatsGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfferStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.OfferStatsGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfferStats indicates an expected call of GetOfferStats.
+func (mr *MockIRepositoryMockRecorder) GetOfferStats(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfferStats", reflect.TypeOf((*MockIRepository)(nil).GetOfferStats), arg0, arg1, arg2)
+}
+
+// GetOutboxStats mocks base method.
+func (m *MockIRepository) GetOutboxStats(arg0 context.Context) (models.OutboxStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboxStats", arg0)
+	ret0, _ := ret[0].(models.OutboxStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboxStats indicates an expected call of GetOutboxStats.
+func (mr *MockIRepositoryMockRecorder) GetOutboxStats(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboxStats", reflect.TypeOf((*MockIRepository)(nil).GetOutboxStats), arg0)
+}
+
+// GetTask mocks base method.
+func (m *MockIRepository) GetTask(arg0 context.Context, arg1 string) (*models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", arg0, arg1)
+	ret0, _ := ret[0].(*models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTask indicates an expected call of GetTask.
+func (mr *MockIRepositoryMockRecorder) GetTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockIRepository)(nil).GetTask), arg0, arg1)
+}
+
+// LastOfferEventPosition mocks base method.
+func (m *MockIRepository) LastOfferEventPosition(arg0 context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastOfferEventPosition", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastOfferEventPosition indicates an expected call of LastOfferEventPosition.
+func (mr *MockIRepositoryMockRecorder) LastOfferEventPosition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastOfferEventPosition", reflect.TypeOf((*MockIRepository)(nil).LastOfferEventPosition), arg0)
+}
+
 // ListOffer mocks base method.
-func (m *MockIRepository) ListOffer(arg0 context.Context, arg1 models.PaginationInput) ([]models.Offer, *models.PaginationInfo, error) {
+func (m *MockIRepository) ListOffer(arg0 context.Context, arg1 models.PaginationInput, arg2 models.OfferFilter, arg3 models.OfferOrder) ([]models.Offer, *models.PaginationInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOffer", arg0, arg1)
+	ret := m.ctrl.Call(m, "ListOffer", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]models.Offer)
 	ret1, _ := ret[1].(*models.PaginationInfo)
 	ret2, _ := ret[2].(error)
@@ -76,38 +225,140 @@ func (m *MockIRepository) ListOffer(arg0 context.Context, arg1 models.Pagination
 }
 
 // ListOffer indicates an expected call of ListOffer.
-func (mr *MockIRepositoryMockRecorder) ListOffer(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIRepositoryMockRecorder) ListOffer(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOffer", reflect.TypeOf((*MockIRepository)(nil).ListOffer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOffer", reflect.TypeOf((*MockIRepository)(nil).ListOffer), arg0, arg1, arg2, arg3)
+}
+
+// ListOfferEvents mocks base method.
+func (m *MockIRepository) ListOfferEvents(arg0 context.Context, arg1 uint64, arg2 models.OfferFilter, arg3 uint64) ([]models.OfferEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOfferEvents", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.OfferEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOfferEvents indicates an expected call of ListOfferEvents.
+func (mr *MockIRepositoryMockRecorder) ListOfferEvents(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOfferEvents", reflect.TypeOf((*MockIRepository)(nil).ListOfferEvents), arg0, arg1, arg2, arg3)
 }
 
 // MultiCreateOffer mocks base method.
-func (m *MockIRepository) MultiCreateOffer(arg0 context.Context, arg1 []models.Offer) (uint64, error) {
+func (m *MockIRepository) MultiCreateOffer(arg0 context.Context, arg1 []models.Offer, arg2 models.ConflictPolicy, arg3 bool) ([]models.CreateResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MultiCreateOffer", arg0, arg1)
-	ret0, _ := ret[0].(uint64)
+	ret := m.ctrl.Call(m, "MultiCreateOffer", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.CreateResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MultiCreateOffer indicates an expected call of MultiCreateOffer.
-func (mr *MockIRepositoryMockRecorder) MultiCreateOffer(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIRepositoryMockRecorder) MultiCreateOffer(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiCreateOffer", reflect.TypeOf((*MockIRepository)(nil).MultiCreateOffer), arg0, arg1, arg2, arg3)
+}
+
+// PatchOffer mocks base method.
+func (m *MockIRepository) PatchOffer(arg0 context.Context, arg1 models.Offer, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchOffer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchOffer indicates an expected call of PatchOffer.
+func (mr *MockIRepositoryMockRecorder) PatchOffer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchOffer", reflect.TypeOf((*MockIRepository)(nil).PatchOffer), arg0, arg1, arg2)
+}
+
+// PurgeOffers mocks base method.
+func (m *MockIRepository) PurgeOffers(arg0 context.Context, arg1 time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeOffers", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeOffers indicates an expected call of PurgeOffers.
+func (mr *MockIRepositoryMockRecorder) PurgeOffers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeOffers", reflect.TypeOf((*MockIRepository)(nil).PurgeOffers), arg0, arg1)
+}
+
+// PurgeOutbox mocks base method.
+func (m *MockIRepository) PurgeOutbox(arg0 context.Context, arg1 time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeOutbox", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeOutbox indicates an expected call of PurgeOutbox.
+func (mr *MockIRepositoryMockRecorder) PurgeOutbox(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiCreateOffer", reflect.TypeOf((*MockIRepository)(nil).MultiCreateOffer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeOutbox", reflect.TypeOf((*MockIRepository)(nil).PurgeOutbox), arg0, arg1)
+}
+
+// RelayOutbox mocks base method.
+func (m *MockIRepository) RelayOutbox(arg0 context.Context, arg1 uint64, arg2 repo.OutboxPublisher) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayOutbox", arg0, arg1, arg2)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayOutbox indicates an expected call of RelayOutbox.
+func (mr *MockIRepositoryMockRecorder) RelayOutbox(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutbox", reflect.TypeOf((*MockIRepository)(nil).RelayOutbox), arg0, arg1, arg2)
 }
 
 // RemoveOffer mocks base method.
-func (m *MockIRepository) RemoveOffer(arg0 context.Context, arg1 uint64) error {
+func (m *MockIRepository) RemoveOffer(arg0 context.Context, arg1, arg2 uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveOffer", arg0, arg1)
+	ret := m.ctrl.Call(m, "RemoveOffer", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveOffer indicates an expected call of RemoveOffer.
-func (mr *MockIRepositoryMockRecorder) RemoveOffer(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIRepositoryMockRecorder) RemoveOffer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOffer", reflect.TypeOf((*MockIRepository)(nil).RemoveOffer), arg0, arg1, arg2)
+}
+
+// RestoreOffer mocks base method.
+func (m *MockIRepository) RestoreOffer(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreOffer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreOffer indicates an expected call of RestoreOffer.
+func (mr *MockIRepositoryMockRecorder) RestoreOffer(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOffer", reflect.TypeOf((*MockIRepository)(nil).RemoveOffer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOffer", reflect.TypeOf((*MockIRepository)(nil).RestoreOffer), arg0, arg1)
+}
+
+// StartTaskPart mocks base method.
+func (m *MockIRepository) StartTaskPart(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTaskPart", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartTaskPart indicates an expected call of StartTaskPart.
+func (mr *MockIRepositoryMockRecorder) StartTaskPart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTaskPart", reflect.TypeOf((*MockIRepository)(nil).StartTaskPart), arg0, arg1)
 }
 
 // UpdateOffer mocks base method.
@@ -123,3 +374,17 @@ func (mr *MockIRepositoryMockRecorder) UpdateOffer(arg0, arg1 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOffer", reflect.TypeOf((*MockIRepository)(nil).UpdateOffer), arg0, arg1)
 }
+
+// UpdateOfferStatus mocks base method.
+func (m *MockIRepository) UpdateOfferStatus(arg0 context.Context, arg1 uint64, arg2, arg3 models.OfferStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOfferStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOfferStatus indicates an expected call of UpdateOfferStatus.
+func (mr *MockIRepositoryMockRecorder) UpdateOfferStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOfferStatus", reflect.TypeOf((*MockIRepository)(nil).UpdateOfferStatus), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,22 @@
+package models
+
+import "context"
+
+// DefaultActor - автор изменений, если он не был передан.
+const DefaultActor = "anonymous"
+
+type actorKey struct{}
+
+// ContextWithActor - сохраняет в контексте автора изменений, который попадёт в историю офера.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext - автор изменений из контекста или DefaultActor.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+
+	return DefaultActor
+}
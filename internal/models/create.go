@@ -0,0 +1,50 @@
+package models
+
+// ConflictPolicy - что делать при создании офера, если живой офер того же пользователя в той же команде уже есть.
+// Значения совпадают с перечислением ConflictPolicy из protobuf.
+type ConflictPolicy uint8
+
+const (
+	// Как ConflictPolicyFail
+	ConflictPolicyUnspecified ConflictPolicy = iota
+	// Создание завершается ошибкой
+	ConflictPolicyFail
+	// Существующий офер не меняется
+	ConflictPolicySkip
+	// У существующего офера меняется грейд
+	ConflictPolicyUpdateGrade
+)
+
+// CreateAction - что произошло с офером при создании.
+// Значения совпадают с перечислением CreateOfferResult из protobuf.
+type CreateAction uint8
+
+const (
+	// Офер не создан из-за ошибки
+	CreateActionUnspecified CreateAction = iota
+	CreateActionInserted
+	// Изменён грейд существующего офера
+	CreateActionUpdated
+	// Существующий офер оставлен как есть
+	CreateActionSkipped
+)
+
+// CreateResult - результат создания одного офера из пакета.
+type CreateResult struct {
+	ID     uint64       // Идентификатор нового или существующего офера, 0 если офер не создан
+	Action CreateAction // Что произошло с офером
+	Err    error        // Ошибка создания офера, только в режиме best effort
+}
+
+// CountCreated - количество вставленных оферов.
+func CountCreated(results []CreateResult) uint64 {
+	var count uint64
+
+	for _, result := range results {
+		if result.Action == CreateActionInserted {
+			count++
+		}
+	}
+
+	return count
+}
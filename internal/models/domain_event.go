@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+// OfferEventSchemaVersion - версия схемы OfferDomainEvent, увеличивается при несовместимых изменениях.
+const OfferEventSchemaVersion = 1
+
+// Типы событий об изменении офера.
+const (
+	OfferEventCreated  = "OfferCreated"
+	OfferEventUpdated  = "OfferUpdated"
+	OfferEventRemoved  = "OfferRemoved"
+	OfferEventRestored = "OfferRestored"
+)
+
+var offerEventTypes = map[OfferHistoryAction]string{
+	OfferHistoryActionCreated:  OfferEventCreated,
+	OfferHistoryActionUpdated:  OfferEventUpdated,
+	OfferHistoryActionRemoved:  OfferEventRemoved,
+	OfferHistoryActionRestored: OfferEventRestored,
+}
+
+// OfferDomainEvent - событие об изменении офера, которое публикуется для других сервисов.
+type OfferDomainEvent struct {
+	// Идентификатор события, совпадает с идентификатором ревизии и возрастает
+	ID            uint64        `json:"id"`
+	Type          string        `json:"type"`
+	SchemaVersion uint32        `json:"schema_version"`
+	OccurredAt    time.Time     `json:"occurred_at"`
+	Actor         string        `json:"actor"`
+	Revision      uint64        `json:"revision"`
+	Offer         OfferSnapshot `json:"offer"`
+	Changes       []FieldChange `json:"changes"`
+}
+
+// OfferSnapshot - состояние офера сразу после изменения.
+type OfferSnapshot struct {
+	ID        uint64     `json:"id"`
+	UserID    uint64     `json:"user_id"`
+	TeamID    uint64     `json:"team_id"`
+	Grade     uint64     `json:"grade"`
+	Status    string     `json:"status"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	IsDeleted bool       `json:"is_deleted"`
+	Version   uint64     `json:"version"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// OfferEventType - тип события для действия из истории офера.
+func OfferEventType(action OfferHistoryAction) string {
+	if eventType, ok := offerEventTypes[action]; ok {
+		return eventType
+	}
+
+	return "OfferUnknown"
+}
+
+// NewOfferDomainEvent - событие о ревизии офера.
+func NewOfferDomainEvent(event *OfferEvent) *OfferDomainEvent {
+	changes := event.Changes
+	if changes == nil {
+		changes = []FieldChange{}
+	}
+
+	return &OfferDomainEvent{
+		ID:            event.ID,
+		Type:          OfferEventType(event.Action),
+		SchemaVersion: OfferEventSchemaVersion,
+		OccurredAt:    event.CreatedAt,
+		Actor:         event.Actor,
+		Revision:      event.Revision,
+		Offer: OfferSnapshot{
+			ID:        event.Offer.ID,
+			UserID:    event.Offer.UserID,
+			TeamID:    event.Offer.TeamID,
+			Grade:     event.Offer.Grade,
+			Status:    event.Offer.Status.String(),
+			ExpiresAt: event.Offer.ExpiresAt,
+			IsDeleted: event.Offer.IsDeleted,
+			Version:   event.Offer.Version,
+			CreatedAt: event.Offer.CreatedAt,
+			UpdatedAt: event.Offer.UpdatedAt,
+		},
+		Changes: changes,
+	}
+}
@@ -0,0 +1,81 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOfferDomainEvent(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	offer := models.Offer{
+		ID: 1, UserID: 2, TeamID: 3, Grade: 4, Status: models.OfferStatusSent, Version: 2,
+		CreatedAt: createdAt, UpdatedAt: createdAt,
+	}
+
+	// Проверка нескольких тестовых кейсов
+	testCases := []struct {
+		name      string                    // Название теста
+		action    models.OfferHistoryAction // Действие из истории
+		eventType string                    // Тип события
+	}{
+		{name: "Created", action: models.OfferHistoryActionCreated, eventType: models.OfferEventCreated},
+		{name: "Updated", action: models.OfferHistoryActionUpdated, eventType: models.OfferEventUpdated},
+		{name: "Removed", action: models.OfferHistoryActionRemoved, eventType: models.OfferEventRemoved},
+		{name: "Restored", action: models.OfferHistoryActionRestored, eventType: models.OfferEventRestored},
+		{name: "Unknown", action: models.OfferHistoryActionUnspecified, eventType: "OfferUnknown"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := models.NewOfferDomainEvent(&models.OfferEvent{
+				OfferRevision: models.OfferRevision{
+					ID: 10, OfferID: 1, Revision: 2, Action: tc.action, Actor: "recruiter", CreatedAt: createdAt,
+				},
+				Offer: offer,
+			})
+
+			assert.Equal(t, &models.OfferDomainEvent{
+				ID:            10,
+				Type:          tc.eventType,
+				SchemaVersion: models.OfferEventSchemaVersion,
+				OccurredAt:    createdAt,
+				Actor:         "recruiter",
+				Revision:      2,
+				Offer: models.OfferSnapshot{
+					ID: 1, UserID: 2, TeamID: 3, Grade: 4, Status: "sent", Version: 2,
+					CreatedAt: createdAt, UpdatedAt: createdAt,
+				},
+				Changes: []models.FieldChange{},
+			}, event)
+		})
+	}
+}
+
+func TestNewOfferEventMessage(t *testing.T) {
+	t.Parallel()
+
+	message := models.NewOfferEventMessage(&models.OfferEvent{
+		OfferRevision: models.OfferRevision{ID: 10, OfferID: 7, Action: models.OfferHistoryActionRemoved},
+		Offer:         models.Offer{ID: 7, IsDeleted: true},
+	})
+
+	assert.Equal(t, "7", message.Key)
+	assert.Equal(t, models.OfferEventRemoved, message.Type)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(message.Payload, &payload))
+	assert.Equal(t, models.OfferEventRemoved, payload["type"])
+	assert.Equal(t, float64(models.OfferEventSchemaVersion), payload["schema_version"])
+	assert.Equal(t, true, payload["offer"].(map[string]interface{})["is_deleted"])
+	assert.NotContains(t, payload["offer"], "expires_at")
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidResumeToken - токен возобновления повреждён или выдан не этим сервисом.
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// OfferEvent - ревизия офера вместе с состоянием офера после ревизии.
+type OfferEvent struct {
+	OfferRevision
+	// Позиция в потоке событий. Назначается при фиксации транзакции,
+	// поэтому в отличие от идентификатора ревизии возрастает в порядке фиксации.
+	Position uint64
+	Offer    Offer
+}
+
+// resumeToken - позиции ревизий, записанных до введения позиций, равны их идентификаторам,
+// поэтому ранее выданные токены остаются действительными.
+type resumeToken struct {
+	Position uint64 `json:"event"`
+}
+
+// EncodeResumeToken - непрозрачный токен, после которого продолжается поток событий.
+func EncodeResumeToken(position uint64) string {
+	data, _ := json.Marshal(resumeToken{Position: position})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeResumeToken - позиция последнего полученного события.
+func DecodeResumeToken(token string) (uint64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidResumeToken
+	}
+
+	var result resumeToken
+	if err := json.Unmarshal(data, &result); err != nil || result.Position == 0 {
+		return 0, ErrInvalidResumeToken
+	}
+
+	return result.Position, nil
+}
@@ -0,0 +1,55 @@
+package models
+
+import "errors"
+
+// ErrInvalidGradeRange - нижняя граница грейда больше верхней.
+var ErrInvalidGradeRange = errors.New("grade_min must not be greater than grade_max")
+
+// OfferFilter - условия выборки оферов, офер должен удовлетворять всем заданным условиям.
+type OfferFilter struct {
+	// Пустой слайс - без ограничения
+	UserIDs []uint64
+	TeamIDs []uint64
+	// Границы грейда включительно, 0 - без ограничения
+	GradeMin uint64
+	GradeMax uint64
+	// Выбирать и удалённые оферы
+	IncludeDeleted bool
+}
+
+// Validate - проверка согласованности условий.
+func (f *OfferFilter) Validate() error {
+	if f.GradeMin != 0 && f.GradeMax != 0 && f.GradeMin > f.GradeMax {
+		return ErrInvalidGradeRange
+	}
+
+	return nil
+}
+
+// Match - офер удовлетворяет всем условиям фильтра.
+func (f *OfferFilter) Match(offer *Offer) bool {
+	switch {
+	case offer.IsDeleted && !f.IncludeDeleted:
+		return false
+	case len(f.UserIDs) > 0 && !containsID(f.UserIDs, offer.UserID):
+		return false
+	case len(f.TeamIDs) > 0 && !containsID(f.TeamIDs, offer.TeamID):
+		return false
+	case f.GradeMin != 0 && offer.Grade < f.GradeMin:
+		return false
+	case f.GradeMax != 0 && offer.Grade > f.GradeMax:
+		return false
+	}
+
+	return true
+}
+
+func containsID(ids []uint64, id uint64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+
+	return false
+}
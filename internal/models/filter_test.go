@@ -0,0 +1,39 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfferFilterMatch(t *testing.T) {
+	t.Parallel()
+	offer := models.Offer{ID: 1, UserID: 10, TeamID: 20, Grade: 3}
+	removed := models.Offer{ID: 2, UserID: 10, TeamID: 20, Grade: 3, IsDeleted: true}
+	// Проверка соответствия офера фильтру
+	testCases := []struct {
+		name   string             // Название теста
+		filter models.OfferFilter // Фильтр
+		offer  models.Offer       // Проверяемый офер
+		result bool               // Офер подходит
+	}{
+		{name: "Empty filter", offer: offer, result: true},
+		{name: "Removed offer", offer: removed, result: false},
+		{name: "Removed offer included", filter: models.OfferFilter{IncludeDeleted: true}, offer: removed, result: true},
+		{name: "Listed user", filter: models.OfferFilter{UserIDs: []uint64{9, 10}}, offer: offer, result: true},
+		{name: "Other user", filter: models.OfferFilter{UserIDs: []uint64{9}}, offer: offer, result: false},
+		{name: "Other team", filter: models.OfferFilter{TeamIDs: []uint64{21}}, offer: offer, result: false},
+		{name: "Grade in range", filter: models.OfferFilter{GradeMin: 3, GradeMax: 3}, offer: offer, result: true},
+		{name: "Grade below range", filter: models.OfferFilter{GradeMin: 4}, offer: offer, result: false},
+		{name: "Grade above range", filter: models.OfferFilter{GradeMax: 2}, offer: offer, result: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.result, tc.filter.Match(&tc.offer))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+// OfferHistoryAction - вид записи, которая породила ревизию офера.
+// Значения совпадают с перечислением OfferHistoryAction из protobuf.
+type OfferHistoryAction uint8
+
+const (
+	OfferHistoryActionUnspecified OfferHistoryAction = iota
+	OfferHistoryActionCreated
+	OfferHistoryActionUpdated
+	OfferHistoryActionRemoved
+	OfferHistoryActionRestored
+)
+
+// FieldChange - старое и новое значение одного поля офера.
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// OfferRevision - одно изменение офера.
+type OfferRevision struct {
+	ID        uint64             `db:"id"`
+	OfferID   uint64             `db:"offer_id"`
+	Revision  uint64             `db:"revision"`
+	Action    OfferHistoryAction `db:"action"`
+	Actor     string             `db:"actor"`
+	CreatedAt time.Time          `db:"created_at"`
+	Changes   []FieldChange      `db:"changes"`
+}
+
+// DiffOffers - список изменившихся полей между "before" и "after".
+// Для только что созданного офера "before" равен nil и старые значения пустые.
+func DiffOffers(before, after *Offer) []FieldChange {
+	oldValues := offerFieldValues(before)
+	newValues := offerFieldValues(after)
+
+	changes := make([]FieldChange, 0)
+
+	for i, field := range offerHistoryFields {
+		if oldValues[i] != newValues[i] {
+			changes = append(changes, FieldChange{
+				Field:    field,
+				OldValue: oldValues[i],
+				NewValue: newValues[i],
+			})
+		}
+	}
+
+	return changes
+}
+
+// offerHistoryFields - поля офера, изменения которых попадают в историю.
+var offerHistoryFields = []string{"user_id", "team_id", "grade", "status", "expires_at", "is_deleted"}
+
+// offerFieldValues - значения полей в порядке offerHistoryFields.
+func offerFieldValues(offer *Offer) []string {
+	if offer == nil {
+		return make([]string, len(offerHistoryFields))
+	}
+
+	expiresAt := ""
+	if offer.ExpiresAt != nil {
+		expiresAt = offer.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	return []string{
+		strconv.FormatUint(offer.UserID, 10),
+		strconv.FormatUint(offer.TeamID, 10),
+		strconv.FormatUint(offer.Grade, 10),
+		offer.Status.String(),
+		expiresAt,
+		strconv.FormatBool(offer.IsDeleted),
+	}
+}
@@ -0,0 +1,77 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffOffers(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	offer := models.Offer{ID: 1, UserID: 2, TeamID: 3, Grade: 4, Status: models.OfferStatusDraft}
+
+	// Проверка нескольких тестовых кейсов
+	testCases := []struct {
+		name   string               // Название теста
+		before *models.Offer        // Офер до изменения
+		after  models.Offer         // Офер после изменения
+		result []models.FieldChange // Изменившиеся поля
+	}{
+		{
+			name:   "Created offer",
+			before: nil,
+			after:  offer,
+			result: []models.FieldChange{
+				{Field: "user_id", OldValue: "", NewValue: "2"},
+				{Field: "team_id", OldValue: "", NewValue: "3"},
+				{Field: "grade", OldValue: "", NewValue: "4"},
+				{Field: "status", OldValue: "", NewValue: "draft"},
+				{Field: "is_deleted", OldValue: "", NewValue: "false"},
+			},
+		},
+		{
+			name:   "Nothing changed",
+			before: &offer,
+			after:  offer,
+			result: []models.FieldChange{},
+		},
+		{
+			name:   "Grade and expiration changed",
+			before: &offer,
+			after:  models.Offer{ID: 1, UserID: 2, TeamID: 3, Grade: 5, Status: models.OfferStatusDraft, ExpiresAt: &expiresAt},
+			result: []models.FieldChange{
+				{Field: "grade", OldValue: "4", NewValue: "5"},
+				{Field: "expires_at", OldValue: "", NewValue: "2021-09-01T12:00:00Z"},
+			},
+		},
+		{
+			name:   "Removed offer",
+			before: &offer,
+			after:  models.Offer{ID: 1, UserID: 2, TeamID: 3, Grade: 4, Status: models.OfferStatusDraft, IsDeleted: true},
+			result: []models.FieldChange{
+				{Field: "is_deleted", OldValue: "false", NewValue: "true"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.result, models.DiffOffers(tc.before, &tc.after))
+		})
+	}
+}
+
+func TestActorFromContext(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, models.DefaultActor, models.ActorFromContext(context.Background()))
+	assert.Equal(t, models.DefaultActor, models.ActorFromContext(models.ContextWithActor(context.Background(), "")))
+	assert.Equal(t, "recruiter", models.ActorFromContext(models.ContextWithActor(context.Background(), "recruiter")))
+}
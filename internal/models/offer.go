@@ -1,16 +1,40 @@
 package models
 
 import (
+	"errors"
 	"fmt"
+	"time"
 )
 
+// ErrOfferNotRemoved - восстановить можно только удалённый офер.
+var ErrOfferNotRemoved = errors.New("offer is not removed")
+
+// ErrOfferVersionMismatch - офер был изменён после того, как клиент получил его версию.
+var ErrOfferVersionMismatch = errors.New("offer version mismatch")
+
+// ErrUnknownOfferField - поле офера нельзя изменить частично или его не существует.
+var ErrUnknownOfferField = errors.New("unknown offer field")
+
+// OfferPatchFields - поля офера, которые можно изменить частично.
+var OfferPatchFields = []string{"user_id", "team_id", "grade", "expires_at"}
+
 // Offer - информаци о выданном офере обучающемуся.
 type Offer struct {
-	IsDeleted bool   `db:"is_deleted"`
-	ID        uint64 `db:"id"`
-	UserID    uint64 `db:"user_id"`
-	TeamID    uint64 `db:"team_id"`
-	Grade     uint64 `db:"grade"`
+	IsDeleted bool        `db:"is_deleted"`
+	Status    OfferStatus `db:"status"`
+	ID        uint64      `db:"id"`
+	UserID    uint64      `db:"user_id"`
+	TeamID    uint64      `db:"team_id"`
+	Grade     uint64      `db:"grade"`
+	// Момент, после которого отправленный офер без ответа считается просроченным.
+	// nil - офер не истекает.
+	ExpiresAt *time.Time `db:"expires_at" structs:",omitnested"`
+	// Версия офера, увеличивается при каждом изменении.
+	// При записи - ожидаемая версия, 0 - без проверки.
+	Version uint64 `db:"version"`
+	// Заполняются базой данных
+	CreatedAt time.Time `db:"created_at" structs:",omitnested"`
+	UpdatedAt time.Time `db:"updated_at" structs:",omitnested"`
 }
 
 func (o *Offer) String() string {
@@ -19,3 +43,23 @@ func (o *Offer) String() string {
 		o.ID, o.UserID, o.Grade, o.TeamID, o.IsDeleted,
 	)
 }
+
+// ApplyFields - копирует из src в офер перечисленные поля из OfferPatchFields.
+func (o *Offer) ApplyFields(src *Offer, fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case "user_id":
+			o.UserID = src.UserID
+		case "team_id":
+			o.TeamID = src.TeamID
+		case "grade":
+			o.Grade = src.Grade
+		case "expires_at":
+			o.ExpiresAt = src.ExpiresAt
+		default:
+			return fmt.Errorf("%w: %q", ErrUnknownOfferField, field)
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package models_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ozoncp/ocp-offer-api/internal/models"
 	"github.com/stretchr/testify/assert"
@@ -37,3 +38,53 @@ func TestOfferString(t *testing.T) {
 		})
 	}
 }
+
+func TestOfferApplyFields(t *testing.T) {
+	t.Parallel()
+	expiresAt := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	src := models.Offer{ID: 9, UserID: 10, TeamID: 20, Grade: 30, ExpiresAt: &expiresAt, Version: 9}
+	// Проверка частичного изменения офера
+	testCases := []struct {
+		name    string       // Название теста
+		fields  []string     // Изменяемые поля
+		result  models.Offer // Офер после изменения
+		isError bool         // Если должна вернуться ошибка
+	}{
+		{
+			name:   "Only grade",
+			fields: []string{"grade"},
+			result: models.Offer{ID: 1, UserID: 1, TeamID: 2, Grade: 30, Version: 3},
+		},
+		{
+			name:   "All patch fields",
+			fields: models.OfferPatchFields,
+			result: models.Offer{ID: 1, UserID: 10, TeamID: 20, Grade: 30, ExpiresAt: &expiresAt, Version: 3},
+		},
+		{
+			name:   "No fields",
+			fields: []string{},
+			result: models.Offer{ID: 1, UserID: 1, TeamID: 2, Grade: 3, Version: 3},
+		},
+		{
+			name:    "Not patchable field",
+			fields:  []string{"grade", "status"},
+			isError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			offer := models.Offer{ID: 1, UserID: 1, TeamID: 2, Grade: 3, Version: 3}
+			err := offer.ApplyFields(&src, tc.fields)
+			if tc.isError {
+				assert.ErrorIs(t, err, models.ErrUnknownOfferField)
+
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.result, offer)
+		})
+	}
+}
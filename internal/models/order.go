@@ -0,0 +1,176 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidOrder - сортировка не по разрешённому полю или с неизвестным направлением.
+var ErrInvalidOrder = errors.New("invalid order")
+
+// offerOrderFields - поля офера, по которым можно сортировать.
+var offerOrderFields = map[string]bool{
+	"id":         true,
+	"user_id":    true,
+	"team_id":    true,
+	"grade":      true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// OfferOrder - сортировка оферов, при равенстве поля оферы упорядочены по id в том же направлении.
+type OfferOrder struct {
+	Field string
+	Desc  bool
+}
+
+// DefaultOfferOrder - сортировка по умолчанию.
+var DefaultOfferOrder = OfferOrder{Field: "id"}
+
+// ParseOfferOrder - разбор строки вида "grade desc", пустая строка - сортировка по умолчанию.
+func ParseOfferOrder(orderBy string) (OfferOrder, error) {
+	parts := strings.Fields(orderBy)
+
+	switch {
+	case len(parts) == 0:
+		return DefaultOfferOrder, nil
+	case len(parts) > 2 || !offerOrderFields[parts[0]]:
+		return OfferOrder{}, fmt.Errorf("%w: %q", ErrInvalidOrder, orderBy)
+	}
+
+	order := OfferOrder{Field: parts[0]}
+
+	if len(parts) == 2 {
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return OfferOrder{}, fmt.Errorf("%w: %q", ErrInvalidOrder, orderBy)
+		}
+	}
+
+	return order, nil
+}
+
+// Validate - сортировка по разрешённому полю.
+func (o OfferOrder) Validate() error {
+	if !offerOrderFields[o.Field] {
+		return fmt.Errorf("%w: %q", ErrInvalidOrder, o.Field)
+	}
+
+	return nil
+}
+
+func (o OfferOrder) String() string {
+	if o.Desc {
+		return o.Field + " desc"
+	}
+
+	return o.Field + " asc"
+}
+
+// Less - офер "a" идёт раньше офера "b" в этой сортировке.
+func (o OfferOrder) Less(a, b *Offer) bool {
+	less, greater := a.ID < b.ID, a.ID > b.ID
+
+	switch o.Field {
+	case "user_id":
+		less, greater = lessUint(a.UserID, b.UserID, less, greater)
+	case "team_id":
+		less, greater = lessUint(a.TeamID, b.TeamID, less, greater)
+	case "grade":
+		less, greater = lessUint(a.Grade, b.Grade, less, greater)
+	case "created_at":
+		less, greater = lessTime(a.CreatedAt, b.CreatedAt, less, greater)
+	case "updated_at":
+		less, greater = lessTime(a.UpdatedAt, b.UpdatedAt, less, greater)
+	}
+
+	if o.Desc {
+		return greater
+	}
+
+	return less
+}
+
+// lessUint - сравнение значений поля, при равенстве - результат сравнения по id.
+func lessUint(a, b uint64, idLess, idGreater bool) (bool, bool) {
+	if a == b {
+		return idLess, idGreater
+	}
+
+	return a < b, a > b
+}
+
+func lessTime(a, b time.Time, idLess, idGreater bool) (bool, bool) {
+	if a.Equal(b) {
+		return idLess, idGreater
+	}
+
+	return a.Before(b), a.After(b)
+}
+
+// TokenOffer - офер с ключом сортировки последнего элемента страницы, для сравнения через Less.
+func (o OfferOrder) TokenOffer(token *PageToken) (*Offer, error) {
+	if err := token.Check(o); err != nil {
+		return nil, err
+	}
+
+	offer := &Offer{ID: token.LastID}
+	if o.Field == DefaultOfferOrder.Field {
+		return offer, nil
+	}
+
+	value, err := o.ParseSortValue(token.LastValue)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	switch v := value.(type) {
+	case uint64:
+		switch o.Field {
+		case "user_id":
+			offer.UserID = v
+		case "team_id":
+			offer.TeamID = v
+		case "grade":
+			offer.Grade = v
+		}
+	case time.Time:
+		offer.CreatedAt, offer.UpdatedAt = v, v
+	}
+
+	return offer, nil
+}
+
+// SortValue - значение поля сортировки офера для токена страницы.
+func (o OfferOrder) SortValue(offer *Offer) string {
+	switch o.Field {
+	case "user_id":
+		return strconv.FormatUint(offer.UserID, 10)
+	case "team_id":
+		return strconv.FormatUint(offer.TeamID, 10)
+	case "grade":
+		return strconv.FormatUint(offer.Grade, 10)
+	case "created_at":
+		return offer.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case "updated_at":
+		return offer.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	default:
+		return strconv.FormatUint(offer.ID, 10)
+	}
+}
+
+// ParseSortValue - обратное к SortValue преобразование в тип поля.
+func (o OfferOrder) ParseSortValue(value string) (interface{}, error) {
+	switch o.Field {
+	case "created_at", "updated_at":
+		return time.Parse(time.RFC3339Nano, value)
+	default:
+		return strconv.ParseUint(value, 10, 64)
+	}
+}
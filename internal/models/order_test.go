@@ -0,0 +1,118 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOfferOrder(t *testing.T) {
+	t.Parallel()
+	// Проверка разбора сортировки
+	testCases := []struct {
+		name    string            // Название теста
+		orderBy string            // Исходная строка
+		result  models.OfferOrder // Результат разбора
+		isError bool              // Если должна вернуться ошибка
+	}{
+		{name: "Empty", orderBy: "", result: models.DefaultOfferOrder},
+		{name: "Field only", orderBy: "grade", result: models.OfferOrder{Field: "grade"}},
+		{name: "Descending", orderBy: " created_at  DESC ", result: models.OfferOrder{Field: "created_at", Desc: true}},
+		{name: "Ascending", orderBy: "team_id asc", result: models.OfferOrder{Field: "team_id"}},
+		{name: "Unknown field", orderBy: "status desc", isError: true},
+		{name: "Unknown direction", orderBy: "grade down", isError: true},
+		{name: "Several fields", orderBy: "grade desc id", isError: true},
+		{name: "Injection", orderBy: "id; DROP TABLE offer", isError: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := models.ParseOfferOrder(tc.orderBy)
+			if tc.isError {
+				assert.ErrorIs(t, err, models.ErrInvalidOrder)
+
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.result, result)
+		})
+	}
+}
+
+func TestPageTokenCheck(t *testing.T) {
+	t.Parallel()
+	offer := models.Offer{ID: 7, Grade: 3}
+	byGrade := models.OfferOrder{Field: "grade", Desc: true}
+	// Проверка соответствия токена сортировке
+	testCases := []struct {
+		name    string            // Название теста
+		token   *models.PageToken // Токен
+		order   models.OfferOrder // Запрошенная сортировка
+		isError bool              // Если должна вернуться ошибка
+	}{
+		{name: "Default order", token: models.NewPageToken(models.DefaultOfferOrder, &offer), order: models.DefaultOfferOrder},
+		{name: "Same order", token: models.NewPageToken(byGrade, &offer), order: byGrade},
+		{name: "Other order", token: models.NewPageToken(byGrade, &offer), order: models.DefaultOfferOrder, isError: true},
+		{name: "Other direction", token: models.NewPageToken(byGrade, &offer), order: models.OfferOrder{Field: "grade"}, isError: true},
+		{name: "Broken value", token: &models.PageToken{LastID: 7, OrderBy: "grade desc", LastValue: "x"}, order: byGrade, isError: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.token.Check(tc.order)
+			if tc.isError {
+				assert.ErrorIs(t, err, models.ErrInvalidPageToken)
+
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOfferOrderLess(t *testing.T) {
+	t.Parallel()
+	first := models.Offer{ID: 1, Grade: 5}
+	second := models.Offer{ID: 2, Grade: 3}
+	third := models.Offer{ID: 3, Grade: 3}
+	// Проверка сравнения оферов
+	testCases := []struct {
+		name   string            // Название теста
+		order  models.OfferOrder // Сортировка
+		a, b   models.Offer      // Сравниваемые оферы
+		result bool              // Офер "a" идёт раньше "b"
+	}{
+		{name: "By id", order: models.DefaultOfferOrder, a: first, b: second, result: true},
+		{name: "By id desc", order: models.OfferOrder{Field: "id", Desc: true}, a: first, b: second, result: false},
+		{name: "By grade", order: models.OfferOrder{Field: "grade"}, a: first, b: second, result: false},
+		{name: "Equal grade by id", order: models.OfferOrder{Field: "grade"}, a: second, b: third, result: true},
+		{name: "Equal grade by id desc", order: models.OfferOrder{Field: "grade", Desc: true}, a: second, b: third, result: false},
+		{name: "Same offer", order: models.OfferOrder{Field: "grade"}, a: second, b: second, result: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.result, tc.order.Less(&tc.a, &tc.b))
+		})
+	}
+}
+
+func TestOfferOrderTokenOffer(t *testing.T) {
+	t.Parallel()
+	last := models.Offer{ID: 7, Grade: 3}
+	byGrade := models.OfferOrder{Field: "grade", Desc: true}
+
+	offer, err := byGrade.TokenOffer(models.NewPageToken(byGrade, &last))
+	assert.NoError(t, err)
+	assert.Equal(t, &models.Offer{ID: 7, Grade: 3}, offer)
+
+	_, err = models.DefaultOfferOrder.TokenOffer(models.NewPageToken(byGrade, &last))
+	assert.ErrorIs(t, err, models.ErrInvalidPageToken)
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// OutboxMessage - сообщение, записанное в транзакции изменения офера и ожидающее отправки в Kafka.
+type OutboxMessage struct {
+	ID uint64
+	// Тип события, передаётся в заголовке сообщения
+	Type    string
+	Key     string
+	Payload []byte
+	// Количество неудачных попыток отправки
+	Attempts  uint32
+	CreatedAt time.Time
+}
+
+// OutboxStats - состояние очереди неотправленных сообщений.
+type OutboxStats struct {
+	Pending uint64
+	// Момент записи самого старого неотправленного сообщения, nil - очередь пуста
+	OldestCreatedAt *time.Time
+}
+
+// NewOfferEventMessage - сообщение с событием о ревизии офера в JSON, ключ сообщения - идентификатор офера,
+// поэтому события одного офера попадают в одну партицию.
+func NewOfferEventMessage(event *OfferEvent) OutboxMessage {
+	domainEvent := NewOfferDomainEvent(event)
+	payload, _ := json.Marshal(domainEvent)
+
+	return OutboxMessage{
+		Type:    domainEvent.Type,
+		Key:     strconv.FormatUint(event.OfferID, 10),
+		Payload: payload,
+	}
+}
@@ -1,14 +1,23 @@
 package models
 
-import "math"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math"
+)
+
+// ErrInvalidPageToken - токен страницы повреждён или выдан не этим сервисом.
+var ErrInvalidPageToken = errors.New("invalid page token")
 
 type PaginationInfo struct {
-	HasPreviousPage bool   // Has previous page
-	HasNextPage     bool   // Has next page
-	PerPage         uint32 // Items per page - max 10k
-	Page            uint64 // Current page number
-	TotalPages      uint64 // Total pages
-	TotalItems      uint64 // Total items
+	HasPreviousPage bool       // Has previous page
+	HasNextPage     bool       // Has next page
+	PerPage         uint32     // Items per page - max 10k
+	Page            uint64     // Current page number, 0 for keyset pagination
+	TotalPages      uint64     // Total pages
+	TotalItems      uint64     // Total items
+	NextPageToken   *PageToken // Token of the next page, nil on the last page
 }
 
 type PaginationInput struct {
@@ -16,29 +25,104 @@ type PaginationInput struct {
 	Take uint32
 	// The number of skipped elements
 	Skip uint64
+	// Keyset pagination - the listing continues after the token, nil - from the start
+	PageToken *PageToken
+	// Don't count the total items
+	SkipCount bool
+}
+
+// PageToken - ключ сортировки последнего элемента страницы.
+type PageToken struct {
+	LastID uint64 `json:"id"`
+	// Сортировка, для которой выдан токен, пустая - сортировка по умолчанию
+	OrderBy string `json:"order,omitempty"`
+	// Значение поля сортировки последнего элемента, если сортировка не по id
+	LastValue string `json:"value,omitempty"`
+}
+
+// NewPageToken - токен страницы, которая заканчивается на офере "last".
+func NewPageToken(order OfferOrder, last *Offer) *PageToken {
+	token := &PageToken{LastID: last.ID}
+
+	if order != DefaultOfferOrder {
+		token.OrderBy = order.String()
+	}
+
+	if order.Field != DefaultOfferOrder.Field {
+		token.LastValue = order.SortValue(last)
+	}
+
+	return token
+}
+
+// Check - токен выдан для той же сортировки и его значение разбирается.
+func (t *PageToken) Check(order OfferOrder) error {
+	tokenOrder, err := ParseOfferOrder(t.OrderBy)
+	if err != nil || tokenOrder != order {
+		return ErrInvalidPageToken
+	}
+
+	if order.Field == DefaultOfferOrder.Field {
+		return nil
+	}
+
+	if _, err := order.ParseSortValue(t.LastValue); err != nil {
+		return ErrInvalidPageToken
+	}
+
+	return nil
+}
+
+// Encode - непрозрачное строковое представление токена.
+func (t *PageToken) Encode() string {
+	data, _ := json.Marshal(t)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePageToken - разбор токена, полученного от Encode.
+func DecodePageToken(token string) (*PageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var result PageToken
+	if err := json.Unmarshal(data, &result); err != nil || result.LastID == 0 {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &result, nil
 }
 
 // Get pagination information.
-func (p *PaginationInput) GetPaginationInfo(perPage uint32, totalItems uint64) *PaginationInfo {
-	totalPages := uint64(math.Ceil(float64(totalItems) / float64(p.Take)))
-	page := uint64(math.Ceil(float64(p.Skip)/float64(p.Take) + 1))
+// The next page token is nil if the page is the last one, totalItems is ignored if SkipCount is set.
+func (p *PaginationInput) GetPaginationInfo(perPage uint32, totalItems uint64, next *PageToken) *PaginationInfo {
+	var page, totalPages uint64
 
-	hasPreviousPage := false
-	if page > 1 {
-		hasPreviousPage = true
+	if !p.SkipCount {
+		totalPages = uint64(math.Ceil(float64(totalItems) / float64(p.Take)))
+	} else {
+		totalItems = 0
 	}
 
-	hasNextPage := false
-	if page < totalPages {
-		hasNextPage = true
+	// The page number is unknown when the listing continues after a token
+	if p.PageToken == nil {
+		page = uint64(math.Ceil(float64(p.Skip)/float64(p.Take) + 1))
+	}
+
+	hasPreviousPage := false
+	if page > 1 || p.PageToken != nil {
+		hasPreviousPage = true
 	}
 
 	return &PaginationInfo{
 		HasPreviousPage: hasPreviousPage,
-		HasNextPage:     hasNextPage,
+		HasNextPage:     next != nil,
 		PerPage:         perPage,
 		Page:            page,
 		TotalPages:      totalPages,
 		TotalItems:      totalItems,
+		NextPageToken:   next,
 	}
 }
@@ -0,0 +1,107 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageToken(t *testing.T) {
+	t.Parallel()
+	// Проверка разбора токенов страниц
+	testCases := []struct {
+		name    string            // Название теста
+		token   string            // Токен
+		result  *models.PageToken // Результат разбора
+		isError bool              // Если должна вернуться ошибка
+	}{
+		{
+			name:   "Encoded token",
+			token:  (&models.PageToken{LastID: 42}).Encode(),
+			result: &models.PageToken{LastID: 42},
+		},
+		{
+			name:    "Not base64",
+			token:   "!!!",
+			isError: true,
+		},
+		{
+			name:    "Not JSON",
+			token:   "YWJj",
+			isError: true,
+		},
+		{
+			name:    "Empty key",
+			token:   (&models.PageToken{}).Encode(),
+			isError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := models.DecodePageToken(tc.token)
+			if tc.isError {
+				assert.ErrorIs(t, err, models.ErrInvalidPageToken)
+
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.result, result)
+		})
+	}
+}
+
+func TestPaginationInputGetPaginationInfo(t *testing.T) {
+	t.Parallel()
+	next := &models.PageToken{LastID: 20}
+	// Проверка расчёта информации о странице
+	testCases := []struct {
+		name       string                 // Название теста
+		input      models.PaginationInput // Запрошенная страница
+		perPage    uint32                 // Элементов на странице
+		totalItems uint64                 // Всего элементов
+		next       *models.PageToken      // Токен следующей страницы
+		result     models.PaginationInfo  // Результат
+	}{
+		{
+			name:       "First page by offset",
+			input:      models.PaginationInput{Take: 10},
+			perPage:    10,
+			totalItems: 25,
+			next:       next,
+			result: models.PaginationInfo{
+				HasNextPage: true, PerPage: 10, Page: 1, TotalPages: 3, TotalItems: 25, NextPageToken: next,
+			},
+		},
+		{
+			name:       "Last page by offset",
+			input:      models.PaginationInput{Take: 10, Skip: 20},
+			perPage:    5,
+			totalItems: 25,
+			result: models.PaginationInfo{
+				HasPreviousPage: true, PerPage: 5, Page: 3, TotalPages: 3, TotalItems: 25,
+			},
+		},
+		{
+			name:    "Page by token without count",
+			input:   models.PaginationInput{Take: 10, PageToken: &models.PageToken{LastID: 10}, SkipCount: true},
+			perPage: 10,
+			next:    next,
+			result: models.PaginationInfo{
+				HasPreviousPage: true, HasNextPage: true, PerPage: 10, NextPageToken: next,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result := tc.input.GetPaginationInfo(tc.perPage, tc.totalItems, tc.next)
+			assert.Equal(t, &tc.result, result)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidTimeRange - начало интервала не раньше его конца.
+var ErrInvalidTimeRange = errors.New("created_from must be before created_to")
+
+// OfferStatsDimension - поле офера, по которому группируется статистика.
+type OfferStatsDimension uint8
+
+const (
+	OfferStatsDimensionUnspecified OfferStatsDimension = iota
+	OfferStatsDimensionTeam
+	OfferStatsDimensionGrade
+	OfferStatsDimensionStatus
+)
+
+// OfferStatsFilter - условия выборки оферов для статистики.
+type OfferStatsFilter struct {
+	OfferFilter
+	// Интервал создания офера [CreatedFrom, CreatedTo), nil - без ограничения
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// Validate - проверка согласованности условий.
+func (f *OfferStatsFilter) Validate() error {
+	if f.CreatedFrom != nil && f.CreatedTo != nil && !f.CreatedFrom.Before(*f.CreatedTo) {
+		return ErrInvalidTimeRange
+	}
+
+	return f.OfferFilter.Validate()
+}
+
+// OfferStatsGroup - количество оферов в группе, заполнены только поля группировки.
+type OfferStatsGroup struct {
+	TeamID uint64
+	Grade  uint64
+	Status OfferStatus
+	Count  uint64
+}
+
+// Match - офер удовлетворяет всем условиям фильтра.
+func (f *OfferStatsFilter) Match(offer *Offer) bool {
+	switch {
+	case f.CreatedFrom != nil && offer.CreatedAt.Before(*f.CreatedFrom):
+		return false
+	case f.CreatedTo != nil && !offer.CreatedAt.Before(*f.CreatedTo):
+		return false
+	}
+
+	return f.OfferFilter.Match(offer)
+}
@@ -0,0 +1,55 @@
+package models
+
+import "errors"
+
+// OfferStatus - этап жизненного цикла офера.
+// Значения совпадают с перечислением OfferStatus из protobuf.
+type OfferStatus uint8
+
+const (
+	OfferStatusUnspecified OfferStatus = iota
+	OfferStatusDraft
+	OfferStatusSent
+	OfferStatusAccepted
+	OfferStatusDeclined
+	OfferStatusWithdrawn
+	OfferStatusExpired
+)
+
+// ErrInvalidStatusTransition - переход между статусами запрещён конечным автоматом.
+var ErrInvalidStatusTransition = errors.New("invalid offer status transition")
+
+// offerStatusTransitions - допустимые переходы: из какого статуса в какие.
+var offerStatusTransitions = map[OfferStatus][]OfferStatus{
+	OfferStatusDraft: {OfferStatusSent, OfferStatusWithdrawn},
+	OfferStatusSent:  {OfferStatusAccepted, OfferStatusDeclined, OfferStatusWithdrawn, OfferStatusExpired},
+}
+
+var offerStatusNames = map[OfferStatus]string{
+	OfferStatusUnspecified: "unspecified",
+	OfferStatusDraft:       "draft",
+	OfferStatusSent:        "sent",
+	OfferStatusAccepted:    "accepted",
+	OfferStatusDeclined:    "declined",
+	OfferStatusWithdrawn:   "withdrawn",
+	OfferStatusExpired:     "expired",
+}
+
+// CanTransitionTo - можно ли перевести офер из текущего статуса в "next".
+func (s OfferStatus) CanTransitionTo(next OfferStatus) bool {
+	for _, allowed := range offerStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s OfferStatus) String() string {
+	if name, ok := offerStatusNames[s]; ok {
+		return name
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,40 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfferStatusCanTransitionTo(t *testing.T) {
+	t.Parallel()
+	// Проверка допустимых и запрещённых переходов
+	testCases := []struct {
+		name   string             // Название теста
+		from   models.OfferStatus // Текущий статус
+		to     models.OfferStatus // Новый статус
+		result bool               // Разрешён ли переход
+	}{
+		{name: "Draft to sent", from: models.OfferStatusDraft, to: models.OfferStatusSent, result: true},
+		{name: "Draft to withdrawn", from: models.OfferStatusDraft, to: models.OfferStatusWithdrawn, result: true},
+		{name: "Draft to accepted", from: models.OfferStatusDraft, to: models.OfferStatusAccepted, result: false},
+		{name: "Sent to accepted", from: models.OfferStatusSent, to: models.OfferStatusAccepted, result: true},
+		{name: "Sent to declined", from: models.OfferStatusSent, to: models.OfferStatusDeclined, result: true},
+		{name: "Sent to withdrawn", from: models.OfferStatusSent, to: models.OfferStatusWithdrawn, result: true},
+		{name: "Sent to expired", from: models.OfferStatusSent, to: models.OfferStatusExpired, result: true},
+		{name: "Draft to expired", from: models.OfferStatusDraft, to: models.OfferStatusExpired, result: false},
+		{name: "Sent to sent", from: models.OfferStatusSent, to: models.OfferStatusSent, result: false},
+		{name: "Accepted to declined", from: models.OfferStatusAccepted, to: models.OfferStatusDeclined, result: false},
+		{name: "Withdrawn to sent", from: models.OfferStatusWithdrawn, to: models.OfferStatusSent, result: false},
+		{name: "Unspecified to sent", from: models.OfferStatusUnspecified, to: models.OfferStatusSent, result: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.result, tc.from.CanTransitionTo(tc.to))
+		})
+	}
+}
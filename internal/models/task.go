@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// TaskType - команда, отправленная задачей в Kafka.
+// Значения совпадают с перечислением TaskType из protobuf.
+type TaskType uint8
+
+const (
+	TaskTypeUnspecified TaskType = iota
+	TaskTypeCreateOffer
+	TaskTypeMultiCreateOffer
+	TaskTypeUpdateOffer
+	TaskTypeRemoveOffer
+)
+
+// TaskStatus - этап обработки задачи.
+// Значения совпадают с перечислением TaskStatus из protobuf.
+type TaskStatus uint8
+
+const (
+	TaskStatusUnspecified TaskStatus = iota
+	// Сообщения задачи отправлены, но ещё не прочитаны
+	TaskStatusQueued
+	TaskStatusProcessing
+	TaskStatusSucceeded
+	TaskStatusFailed
+)
+
+// Task - асинхронная задача, выполняемая kafka-consumer.
+// Задача из нескольких сообщений (частей) завершается, когда обработаны все части,
+// ошибка любой части делает задачу неудачной.
+type Task struct {
+	ID     string
+	Type   TaskType
+	Status TaskStatus
+	// Причина неудачи, пустая для остальных статусов
+	Reason string
+	// Идентификаторы созданных или изменённых оферов
+	OfferIDs  []uint64
+	Parts     uint32
+	PartsDone uint32
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Finished - задача больше не изменится.
+func (t *Task) Finished() bool {
+	return t.Status == TaskStatusSucceeded || t.Status == TaskStatusFailed
+}
+
+// StartPart - consumer начал обработку части задачи.
+func (t *Task) StartPart() {
+	if t.Status == TaskStatusQueued {
+		t.Status = TaskStatusProcessing
+	}
+}
+
+// FinishPart - часть задачи обработана, "err" - ошибка её обработки.
+// Лишние части, например повторно доставленные сообщения, не учитываются.
+func (t *Task) FinishPart(offerIDs []uint64, err error) {
+	if t.PartsDone >= t.Parts {
+		return
+	}
+
+	t.PartsDone++
+	t.OfferIDs = append(t.OfferIDs, offerIDs...)
+
+	if err != nil && t.Status != TaskStatusFailed {
+		t.Status = TaskStatusFailed
+		t.Reason = err.Error()
+	}
+
+	if t.Status != TaskStatusFailed && t.PartsDone == t.Parts {
+		t.Status = TaskStatusSucceeded
+	}
+}
@@ -0,0 +1,74 @@
+package models_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskFinishPart(t *testing.T) {
+	t.Parallel()
+
+	partErr := errors.New("already exists")
+
+	testCases := []struct {
+		name      string            // Название теста
+		task      models.Task       // Задача до обработки части
+		err       error             // Ошибка обработки части
+		status    models.TaskStatus // Ожидаемый статус
+		reason    string            // Ожидаемая причина неудачи
+		partsDone uint32            // Ожидаемое количество обработанных частей
+	}{
+		{
+			name:      "Last part",
+			task:      models.Task{Status: models.TaskStatusProcessing, Parts: 2, PartsDone: 1},
+			status:    models.TaskStatusSucceeded,
+			partsDone: 2,
+		},
+		{
+			name:      "Not last part",
+			task:      models.Task{Status: models.TaskStatusProcessing, Parts: 2},
+			status:    models.TaskStatusProcessing,
+			partsDone: 1,
+		},
+		{
+			name:      "Failed part",
+			task:      models.Task{Status: models.TaskStatusProcessing, Parts: 2},
+			err:       partErr,
+			status:    models.TaskStatusFailed,
+			reason:    partErr.Error(),
+			partsDone: 1,
+		},
+		{
+			name:      "Part of failed task",
+			task:      models.Task{Status: models.TaskStatusFailed, Reason: "first", Parts: 2, PartsDone: 1},
+			err:       partErr,
+			status:    models.TaskStatusFailed,
+			reason:    "first",
+			partsDone: 2,
+		},
+		{
+			name:      "Redelivered part",
+			task:      models.Task{Status: models.TaskStatusSucceeded, Parts: 1, PartsDone: 1},
+			err:       partErr,
+			status:    models.TaskStatusSucceeded,
+			partsDone: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := tc.task
+			task.FinishPart(nil, tc.err)
+
+			assert.Equal(t, tc.status, task.Status)
+			assert.Equal(t, tc.reason, task.Reason)
+			assert.Equal(t, tc.partsDone, task.PartsDone)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package repo
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/lib/pq"
+)
+
+// Kinds of the repository errors, an *Error matches its kind with errors.Is.
+var (
+	// ErrNotFound - the offer does not exist or is removed, or the task does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists - the offer violates a unique constraint.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrConflict - the current state of the offer doesn't allow the change.
+	ErrConflict = errors.New("conflict")
+)
+
+// uniqueViolation - SQLSTATE of the unique constraint violation.
+const uniqueViolation = "23505"
+
+// liveUserTeamIndex - unique index of the user and team of live offers.
+const liveUserTeamIndex = "offer.live_user_team_id_index"
+
+// Error is a repository error of the Kind caused by Err.
+// The message doesn't contain the driver details, they are available through errors.Unwrap.
+type Error struct {
+	Kind    error
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// notFound is the ErrNotFound error of the offer.
+func notFound(offerID uint64, err error) error {
+	return &Error{
+		Kind:    ErrNotFound,
+		Message: fmt.Sprintf("offer %d not found", offerID),
+		Err:     err,
+	}
+}
+
+// taskNotFound is the ErrNotFound error of the task.
+func taskNotFound(taskID string, err error) error {
+	return &Error{
+		Kind:    ErrNotFound,
+		Message: fmt.Sprintf("task %s not found", taskID),
+		Err:     err,
+	}
+}
+
+// alreadyExists is the ErrAlreadyExists error of the unique user and team of live offers.
+func alreadyExists(err error) error {
+	return &Error{
+		Kind:    ErrAlreadyExists,
+		Message: "live offer with the same user_id and team_id already exists",
+		Err:     err,
+	}
+}
+
+// uniqueViolated is the ErrAlreadyExists error of any other unique constraint.
+func uniqueViolated(err error) error {
+	return &Error{
+		Kind:    ErrAlreadyExists,
+		Message: "offer already exists",
+		Err:     err,
+	}
+}
+
+// repeatedKey is the ErrConflict error of a batch repeating the user and team with the update-grade policy.
+func repeatedKey(key userTeam) error {
+	return &Error{
+		Kind:    ErrConflict,
+		Message: fmt.Sprintf("user_id %d and team_id %d are repeated in the batch", key.userID, key.teamID),
+	}
+}
+
+// conflict is the ErrConflict error keeping the message of the domain error.
+func conflict(err error) error {
+	return &Error{
+		Kind:    ErrConflict,
+		Message: err.Error(),
+		Err:     err,
+	}
+}
+
+// offerError converts the errors of a query of the offer to the repository errors.
+// Other errors are returned as is.
+func offerError(offerID uint64, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return notFound(offerID, err)
+	}
+
+	if constraint, ok := uniqueConstraint(err); ok {
+		if constraint == liveUserTeamIndex {
+			return alreadyExists(err)
+		}
+
+		return uniqueViolated(err)
+	}
+
+	return err
+}
+
+// uniqueConstraint returns the name of the unique constraint violated by the error of pgx or pq driver.
+func uniqueConstraint(err error) (string, bool) {
+	var pgxErr *pgconn.PgError
+	if errors.As(err, &pgxErr) && pgxErr.Code == uniqueViolation {
+		return pgxErr.ConstraintName, true
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return pqErr.Constraint, true
+	}
+
+	return "", false
+}
+
+// checkAffected returns ErrNotFound if the statement changed no rows.
+func checkAffected(result sql.Result, offerID uint64) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return notFound(offerID, sql.ErrNoRows)
+	}
+
+	return nil
+}
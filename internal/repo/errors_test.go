@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfferError(t *testing.T) {
+	t.Parallel()
+
+	other := errors.New("connection refused")
+
+	tests := []struct {
+		// Название теста
+		name string
+		// Ошибка драйвера
+		err error
+		// Ожидаемое сообщение
+		message string
+		// Ожидаемый вид ошибки, nil - ошибка возвращается как есть
+		kind error
+	}{
+		{
+			name:    "pgx live user and team",
+			err:     fmt.Errorf("insert: %w", &pgconn.PgError{Code: uniqueViolation, ConstraintName: liveUserTeamIndex}),
+			message: "live offer with the same user_id and team_id already exists",
+			kind:    ErrAlreadyExists,
+		},
+		{
+			name:    "pq live user and team",
+			err:     &pq.Error{Code: uniqueViolation, Constraint: liveUserTeamIndex},
+			message: "live offer with the same user_id and team_id already exists",
+			kind:    ErrAlreadyExists,
+		},
+		{
+			name:    "pgx other unique constraint",
+			err:     &pgconn.PgError{Code: uniqueViolation, ConstraintName: "offer_pkey"},
+			message: "offer already exists",
+			kind:    ErrAlreadyExists,
+		},
+		{
+			name:    "pq other unique constraint",
+			err:     &pq.Error{Code: uniqueViolation, Constraint: "offer_pkey"},
+			message: "offer already exists",
+			kind:    ErrAlreadyExists,
+		},
+		{
+			name:    "other error",
+			err:     other,
+			message: other.Error(),
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := offerError(1, testCase.err)
+
+			assert.EqualError(t, err, testCase.message)
+			if testCase.kind != nil {
+				assert.ErrorIs(t, err, testCase.kind)
+			}
+			assert.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+)
+
+// exportFetchSize - number of rows fetched from the export cursor at once.
+const exportFetchSize = 1000
+
+// ExportOffers calls "fn" for every offer matching the filter in the order of id.
+// The rows are read with a server-side cursor, so the memory usage doesn't depend on the number of offers,
+// and a slow "fn" pauses the reading. An error of "fn" stops the export and is returned as is.
+func (r *Repository) ExportOffers(ctx context.Context, filter models.OfferFilter, fn func(offer *models.Offer) error) error {
+	query, args, err := sq.
+		Select(offerColumns...).
+		From("offer").
+		Where(filterCondition(filter)).
+		OrderBy("id ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	// The cursor lives until the end of the transaction
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	// Nothing is changed, so the transaction is always rolled back
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, "DECLARE offer_export NO SCROLL CURSOR FOR "+query, args...); err != nil {
+		return err
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM offer_export", exportFetchSize)
+
+	for {
+		offers, err := queryOffers(ctx, txQuery{tx: tx, query: fetch})
+		if err != nil {
+			return err
+		}
+
+		for i := range offers {
+			if err := fn(&offers[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(offers) < exportFetchSize {
+			return nil
+		}
+	}
+}
+
+// txQuery is a plain SQL query without arguments run in the transaction.
+type txQuery struct {
+	tx    *sqlx.Tx
+	query string
+}
+
+func (q txQuery) QueryContext(ctx context.Context) (*sql.Rows, error) {
+	return q.tx.QueryContext(ctx, q.query)
+}
@@ -0,0 +1,188 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+)
+
+// GetOfferHistory returns all revisions of the offer ordered from the oldest to the newest.
+// Revisions of removed offers are returned as well.
+func (r *Repository) GetOfferHistory(ctx context.Context, offerID uint64) ([]models.OfferRevision, error) {
+	rows, err := sq.
+		Select("id", "offer_id", "revision", "action", "actor", "created_at", "changes").
+		From("offer_history").
+		Where(sq.Eq{"offer_id": offerID}).
+		OrderBy("revision ASC").
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar).
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]models.OfferRevision, 0)
+	for rows.Next() {
+		var (
+			revision models.OfferRevision
+			changes  []byte
+		)
+
+		if err := rows.Scan(
+			&revision.ID,
+			&revision.OfferID,
+			&revision.Revision,
+			&revision.Action,
+			&revision.Actor,
+			&revision.CreatedAt,
+			&changes,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(changes, &revision.Changes); err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, rows.Err()
+}
+
+// ListOfferEvents returns up to "limit" revisions following the "afterPosition" position in the order of positions,
+// only the revisions leaving the offer matching the filter are returned.
+// The offers are returned in the state after the revision, the revisions of purged offers are returned as well.
+func (r *Repository) ListOfferEvents(
+	ctx context.Context,
+	afterPosition uint64,
+	filter models.OfferFilter,
+	limit uint64,
+) ([]models.OfferEvent, error) {
+	// The snapshot is expanded to the columns of the offer, so the filter applies to it like to the table
+	revisions := sq.
+		Select(
+			"h.id AS revision_id", "h.offer_id", "h.revision", "h.action", "h.actor",
+			"h.created_at AS revision_created_at", "h.changes", "h.position", "s.*",
+		).
+		From("offer_history h").
+		JoinClause("CROSS JOIN LATERAL jsonb_populate_record(NULL::offer, h.offer) s").
+		Where(sq.Gt{"h.position": afterPosition})
+
+	columns := []string{
+		"revision_id", "offer_id", "revision", "action", "actor", "revision_created_at", "changes", "position",
+	}
+
+	rows, err := sq.
+		Select(append(columns, offerColumns...)...).
+		FromSelect(revisions, "e").
+		Where(filterCondition(filter)).
+		OrderBy("position ASC").
+		Limit(limit).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar).
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]models.OfferEvent, 0)
+	for rows.Next() {
+		var (
+			event   models.OfferEvent
+			changes []byte
+		)
+
+		dest := append([]interface{}{
+			&event.ID,
+			&event.OfferID,
+			&event.Revision,
+			&event.Action,
+			&event.Actor,
+			&event.CreatedAt,
+			&changes,
+			&event.Position,
+		}, offerFields(&event.Offer)...)
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(changes, &event.Changes); err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// LastOfferEventPosition returns the position of the latest committed revision, 0 if there are none.
+func (r *Repository) LastOfferEventPosition(ctx context.Context) (uint64, error) {
+	var position uint64
+
+	err := sq.
+		Select("COALESCE(MAX(position), 0)").
+		From("offer_history").
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar).
+		QueryRowContext(ctx).
+		Scan(&position)
+
+	return position, err
+}
+
+// insertHistory appends the next revision of the offer with the state of the offer and puts it to the outbox.
+// It has to be called in the transaction of the write itself after the write: the row lock taken by the write
+// serializes revisions of one offer. The position of the revision is assigned at the commit by a trigger.
+func insertHistory(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	offerID uint64,
+	action models.OfferHistoryAction,
+	changes []models.FieldChange,
+) error {
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	revision := models.OfferRevision{
+		OfferID: offerID,
+		Action:  action,
+		Actor:   models.ActorFromContext(ctx),
+		Changes: changes,
+	}
+
+	err = sq.
+		Insert("offer_history").
+		Columns("offer_id", "revision", "action", "actor", "changes", "offer").
+		Values(
+			offerID,
+			sq.Expr("(SELECT COALESCE(MAX(revision), 0) + 1 FROM offer_history WHERE offer_id = ?)", offerID),
+			action,
+			revision.Actor,
+			string(data),
+			sq.Expr("(SELECT to_jsonb(o) FROM offer o WHERE o.id = ?)", offerID),
+		).
+		Suffix("RETURNING id, revision, created_at").
+		RunWith(tx).
+		PlaceholderFormat(sq.Dollar).
+		QueryRowContext(ctx).
+		Scan(&revision.ID, &revision.Revision, &revision.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return insertOutbox(ctx, tx, &revision)
+}
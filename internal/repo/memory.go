@@ -0,0 +1,811 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	utils "github.com/ozoncp/ocp-offer-api/internal/utils/models"
+)
+
+// MemoryDriver - value of database.driver selecting the in-memory repository.
+const MemoryDriver = "memory"
+
+// MemoryRepository keeps the offers in memory with the semantics of Repository.
+// It is meant for demos and tests without a database, the data is lost on restart.
+type MemoryRepository struct {
+	mu        sync.Mutex
+	batchSize uint
+	onChange  func()
+
+	offers map[uint64]*memoryOffer
+	// Ids of the live offers, the unique index of Repository
+	live map[userTeam]uint64
+	// Revisions of all offers with the states of the offers, the positions are equal to the ids
+	history     []models.OfferEvent
+	revisions   map[uint64]uint64
+	lastOfferID uint64
+	lastEventID uint64
+
+	outbox       []*memoryOutboxMessage
+	lastOutboxID uint64
+	// Held by RelayOutbox while the messages are published without mu
+	relayMu sync.Mutex
+
+	tasks map[string]*models.Task
+}
+
+type memoryOffer struct {
+	offer     models.Offer
+	deletedAt time.Time
+}
+
+type memoryOutboxMessage struct {
+	message models.OutboxMessage
+	sentAt  *time.Time
+}
+
+// NewMemoryRepo creates an empty repository. "onChange" is called after every change of the offers
+// like the offer_history notification of Postgres, it may be nil.
+func NewMemoryRepo(batchSize uint, onChange func()) IRepository {
+	return &MemoryRepository{
+		batchSize: batchSize,
+		onChange:  onChange,
+		offers:    make(map[uint64]*memoryOffer),
+		live:      make(map[userTeam]uint64),
+		revisions: make(map[uint64]uint64),
+		tasks:     make(map[string]*models.Task),
+	}
+}
+
+func (m *MemoryRepository) MultiCreateOffer(
+	ctx context.Context,
+	offers []models.Offer,
+	policy models.ConflictPolicy,
+	bestEffort bool,
+) ([]models.CreateResult, error) {
+	// There are no statements to split, the batches are only checked like in Repository
+	var batches [][]models.Offer
+	if !bestEffort {
+		var err error
+		if batches, err = utils.SplitOffersToBatches(offers, m.batchSize); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]models.CreateResult, len(offers))
+
+	err := m.write(func() error {
+		// Only a conflict fails an offer, so checking them first makes the creation all-or-nothing
+		if !bestEffort {
+			if err := m.checkConflicts(offers, batches, policy); err != nil {
+				return err
+			}
+		}
+
+		for i := range offers {
+			result, err := m.create(ctx, &offers[i], policy)
+			if err != nil {
+				result.Err = err
+			}
+
+			results[i] = result
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (m *MemoryRepository) CreateOffer(
+	ctx context.Context,
+	offer models.Offer,
+	policy models.ConflictPolicy,
+) (models.CreateResult, error) {
+	var result models.CreateResult
+
+	err := m.write(func() (err error) {
+		result, err = m.create(ctx, &offer, policy)
+
+		return err
+	})
+
+	return result, err
+}
+
+func (m *MemoryRepository) UpdateOffer(ctx context.Context, offer models.Offer) error {
+	return m.PatchOffer(ctx, offer, models.OfferPatchFields)
+}
+
+func (m *MemoryRepository) PatchOffer(ctx context.Context, offer models.Offer, fields []string) error {
+	return m.write(func() error {
+		stored, err := m.liveOffer(offer.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkVersion(&stored.offer, offer.Version); err != nil {
+			return err
+		}
+
+		before := stored.offer
+
+		after := before
+		if err := after.ApplyFields(&offer, fields); err != nil {
+			return err
+		}
+
+		oldKey, newKey := userTeam{before.UserID, before.TeamID}, userTeam{after.UserID, after.TeamID}
+		if _, ok := m.live[newKey]; ok && newKey != oldKey {
+			return alreadyExists(nil)
+		}
+
+		delete(m.live, oldKey)
+		m.live[newKey] = after.ID
+
+		m.update(&after)
+		stored.offer = *copyOffer(&after)
+		m.appendHistory(ctx, &before, &after, models.OfferHistoryActionUpdated)
+
+		return nil
+	})
+}
+
+func (m *MemoryRepository) UpdateOfferStatus(ctx context.Context, offerID uint64, from, to models.OfferStatus) error {
+	return m.write(func() error {
+		stored, err := m.liveOffer(offerID)
+		if err != nil {
+			return err
+		}
+
+		if stored.offer.Status != from {
+			return conflict(models.ErrInvalidStatusTransition)
+		}
+
+		before := stored.offer
+		stored.offer.Status = to
+		m.update(&stored.offer)
+		m.appendHistory(ctx, &before, &stored.offer, models.OfferHistoryActionUpdated)
+
+		return nil
+	})
+}
+
+func (m *MemoryRepository) DescribeOffer(ctx context.Context, offerID uint64) (*models.Offer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, err := m.liveOffer(offerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyOffer(&stored.offer), nil
+}
+
+func (m *MemoryRepository) BatchGetOffers(ctx context.Context, offerIDs []uint64) ([]models.Offer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	offers := make([]models.Offer, 0, len(offerIDs))
+
+	for _, id := range offerIDs {
+		if stored, err := m.liveOffer(id); err == nil {
+			offers = append(offers, *copyOffer(&stored.offer))
+		}
+	}
+
+	return offers, nil
+}
+
+func (m *MemoryRepository) ListOffer(
+	ctx context.Context,
+	pagination models.PaginationInput,
+	filter models.OfferFilter,
+	order models.OfferOrder,
+) ([]models.Offer, *models.PaginationInfo, error) {
+	if err := order.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var after *models.Offer
+	if pagination.PageToken != nil {
+		var err error
+		if after, err = order.TokenOffer(pagination.PageToken); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	matched := m.find(filter.Match)
+	sort.Slice(matched, func(i, j int) bool {
+		return order.Less(&matched[i], &matched[j])
+	})
+
+	// The offers are counted only on request like in Repository
+	var totalItems uint64
+	if !pagination.SkipCount {
+		totalItems = uint64(len(matched))
+	}
+
+	offers := matched
+	if after != nil {
+		offers = offers[sort.Search(len(offers), func(i int) bool {
+			return order.Less(after, &offers[i])
+		}):]
+	}
+
+	if pagination.Skip >= uint64(len(offers)) {
+		offers = offers[:0]
+	} else {
+		offers = offers[pagination.Skip:]
+	}
+
+	var next *models.PageToken
+	if len(offers) > int(pagination.Take) {
+		offers = offers[:pagination.Take]
+		next = models.NewPageToken(order, &offers[len(offers)-1])
+	}
+
+	pagInfo := pagination.GetPaginationInfo(uint32(len(offers)), totalItems, next)
+
+	return offers, pagInfo, nil
+}
+
+func (m *MemoryRepository) RemoveOffer(ctx context.Context, offerID uint64, expectedVersion uint64) error {
+	return m.write(func() error {
+		stored, err := m.liveOffer(offerID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkVersion(&stored.offer, expectedVersion); err != nil {
+			return err
+		}
+
+		before := stored.offer
+		stored.offer.IsDeleted = true
+		stored.deletedAt = memoryNow()
+		m.update(&stored.offer)
+		delete(m.live, userTeam{before.UserID, before.TeamID})
+		m.appendHistory(ctx, &before, &stored.offer, models.OfferHistoryActionRemoved)
+
+		return nil
+	})
+}
+
+func (m *MemoryRepository) RestoreOffer(ctx context.Context, offerID uint64) error {
+	return m.write(func() error {
+		stored, ok := m.offers[offerID]
+		if !ok {
+			return notFound(offerID, nil)
+		}
+
+		if !stored.offer.IsDeleted {
+			return conflict(models.ErrOfferNotRemoved)
+		}
+
+		key := userTeam{stored.offer.UserID, stored.offer.TeamID}
+		if _, ok := m.live[key]; ok {
+			return alreadyExists(nil)
+		}
+
+		before := stored.offer
+		stored.offer.IsDeleted = false
+		stored.deletedAt = time.Time{}
+		m.update(&stored.offer)
+		m.live[key] = offerID
+		m.appendHistory(ctx, &before, &stored.offer, models.OfferHistoryActionRestored)
+
+		return nil
+	})
+}
+
+func (m *MemoryRepository) PurgeOffers(ctx context.Context, deletedBefore time.Time) (uint64, error) {
+	var count uint64
+
+	err := m.write(func() error {
+		for id, stored := range m.offers {
+			if stored.offer.IsDeleted && stored.deletedAt.Before(deletedBefore) {
+				delete(m.offers, id)
+				delete(m.revisions, id)
+				count++
+			}
+		}
+
+		// The history of the purged offers is kept like in Repository
+		return nil
+	})
+
+	return count, err
+}
+
+func (m *MemoryRepository) ExpireOffers(ctx context.Context, now time.Time, limit uint64) (uint64, error) {
+	var count uint64
+
+	err := m.write(func() error {
+		overdue := make([]*memoryOffer, 0)
+
+		for _, stored := range m.offers {
+			offer := &stored.offer
+			if offer.Status == models.OfferStatusSent && !offer.IsDeleted &&
+				offer.ExpiresAt != nil && !offer.ExpiresAt.After(now) {
+				overdue = append(overdue, stored)
+			}
+		}
+
+		sort.Slice(overdue, func(i, j int) bool {
+			return overdue[i].offer.ExpiresAt.Before(*overdue[j].offer.ExpiresAt)
+		})
+
+		if uint64(len(overdue)) > limit {
+			overdue = overdue[:limit]
+		}
+
+		for _, stored := range overdue {
+			before := stored.offer
+			stored.offer.Status = models.OfferStatusExpired
+			m.update(&stored.offer)
+			m.appendHistory(ctx, &before, &stored.offer, models.OfferHistoryActionUpdated)
+		}
+
+		count = uint64(len(overdue))
+
+		return nil
+	})
+
+	return count, err
+}
+
+func (m *MemoryRepository) GetOfferHistory(ctx context.Context, offerID uint64) ([]models.OfferRevision, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	revisions := make([]models.OfferRevision, 0)
+
+	for _, event := range m.history {
+		if event.OfferID == offerID {
+			revisions = append(revisions, event.OfferRevision)
+		}
+	}
+
+	return revisions, nil
+}
+
+func (m *MemoryRepository) ListOfferEvents(
+	ctx context.Context,
+	afterPosition uint64,
+	filter models.OfferFilter,
+	limit uint64,
+) ([]models.OfferEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	events := make([]models.OfferEvent, 0)
+
+	start := sort.Search(len(m.history), func(i int) bool {
+		return m.history[i].Position > afterPosition
+	})
+
+	for _, event := range m.history[start:] {
+		if uint64(len(events)) == limit {
+			break
+		}
+
+		if filter.Match(&event.Offer) {
+			event.Offer = *copyOffer(&event.Offer)
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func (m *MemoryRepository) LastOfferEventPosition(ctx context.Context) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.history) == 0 {
+		return 0, nil
+	}
+
+	return m.history[len(m.history)-1].Position, nil
+}
+
+// ExportOffers calls "fn" for a snapshot of the matching offers, so "fn" doesn't block the writes.
+func (m *MemoryRepository) ExportOffers(ctx context.Context, filter models.OfferFilter, fn func(offer *models.Offer) error) error {
+	offers := m.find(filter.Match)
+	sort.Slice(offers, func(i, j int) bool {
+		return offers[i].ID < offers[j].ID
+	})
+
+	for i := range offers {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := fn(&offers[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MemoryRepository) GetOfferStats(
+	ctx context.Context,
+	groupBy []models.OfferStatsDimension,
+	filter models.OfferStatsFilter,
+) ([]models.OfferStatsGroup, error) {
+	for _, dimension := range groupBy {
+		if _, ok := statsColumns[dimension]; !ok {
+			return nil, fmt.Errorf("unknown statistics dimension %d", dimension)
+		}
+	}
+
+	counts := make(map[models.OfferStatsGroup]uint64)
+
+	for _, offer := range m.find(filter.Match) {
+		var group models.OfferStatsGroup
+
+		for _, dimension := range groupBy {
+			switch dimension {
+			case models.OfferStatsDimensionTeam:
+				group.TeamID = offer.TeamID
+			case models.OfferStatsDimensionGrade:
+				group.Grade = offer.Grade
+			case models.OfferStatsDimensionStatus:
+				group.Status = offer.Status
+			}
+		}
+
+		counts[group]++
+	}
+
+	groups := make([]models.OfferStatsGroup, 0, len(counts))
+	for group, count := range counts {
+		group.Count = count
+		groups = append(groups, group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		a, b := &groups[i], &groups[j]
+
+		for _, dimension := range groupBy {
+			switch {
+			case dimension == models.OfferStatsDimensionTeam && a.TeamID != b.TeamID:
+				return a.TeamID < b.TeamID
+			case dimension == models.OfferStatsDimensionGrade && a.Grade != b.Grade:
+				return a.Grade < b.Grade
+			case dimension == models.OfferStatsDimensionStatus && a.Status != b.Status:
+				return a.Status < b.Status
+			}
+		}
+
+		return false
+	})
+
+	return groups, nil
+}
+
+func (m *MemoryRepository) RelayOutbox(ctx context.Context, limit uint64, publish OutboxPublisher) (uint64, error) {
+	m.relayMu.Lock()
+	defer m.relayMu.Unlock()
+
+	m.mu.Lock()
+	pending := make([]*memoryOutboxMessage, 0)
+	messages := make([]models.OutboxMessage, 0)
+
+	for _, stored := range m.outbox {
+		if uint64(len(pending)) == limit {
+			break
+		}
+
+		if stored.sentAt == nil {
+			pending = append(pending, stored)
+			messages = append(messages, stored.message)
+		}
+	}
+	m.mu.Unlock()
+
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	sent, err := publish(messages)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := memoryNow()
+	for _, stored := range pending[:sent] {
+		stored.sentAt = &now
+	}
+
+	if err != nil && sent < uint64(len(pending)) {
+		pending[sent].message.Attempts++
+	}
+
+	return sent, err
+}
+
+func (m *MemoryRepository) GetOutboxStats(ctx context.Context) (models.OutboxStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var stats models.OutboxStats
+
+	for _, stored := range m.outbox {
+		if stored.sentAt != nil {
+			continue
+		}
+
+		if stats.Pending == 0 {
+			createdAt := stored.message.CreatedAt
+			stats.OldestCreatedAt = &createdAt
+		}
+
+		stats.Pending++
+	}
+
+	return stats, nil
+}
+
+func (m *MemoryRepository) PurgeOutbox(ctx context.Context, sentBefore time.Time) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kept := m.outbox[:0]
+
+	for _, stored := range m.outbox {
+		if stored.sentAt == nil || !stored.sentAt.Before(sentBefore) {
+			kept = append(kept, stored)
+		}
+	}
+
+	count := uint64(len(m.outbox) - len(kept))
+	m.outbox = kept
+
+	return count, nil
+}
+
+func (m *MemoryRepository) CreateTask(ctx context.Context, task *models.Task) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.tasks[task.ID]; ok {
+		return &Error{Kind: ErrAlreadyExists, Message: fmt.Sprintf("task %s already exists", task.ID)}
+	}
+
+	now := memoryNow()
+	task.CreatedAt = now
+	task.UpdatedAt = now
+
+	stored := *task
+	stored.OfferIDs = []uint64{}
+	m.tasks[task.ID] = &stored
+
+	return nil
+}
+
+func (m *MemoryRepository) StartTaskPart(ctx context.Context, taskID string) error {
+	return m.changeTask(taskID, func(task *models.Task) {
+		task.StartPart()
+	})
+}
+
+func (m *MemoryRepository) FinishTaskPart(ctx context.Context, taskID string, offerIDs []uint64, taskErr error) error {
+	return m.changeTask(taskID, func(task *models.Task) {
+		task.FinishPart(offerIDs, taskErr)
+	})
+}
+
+func (m *MemoryRepository) GetTask(ctx context.Context, taskID string) (*models.Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.tasks[taskID]
+	if !ok {
+		return nil, taskNotFound(taskID, nil)
+	}
+
+	task := *stored
+	task.OfferIDs = append([]uint64{}, stored.OfferIDs...)
+
+	return &task, nil
+}
+
+// ----------------------------------------------------------------
+
+func (m *MemoryRepository) changeTask(taskID string, fn func(task *models.Task)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return taskNotFound(taskID, nil)
+	}
+
+	fn(task)
+	task.UpdatedAt = memoryNow()
+
+	return nil
+}
+
+// write runs "fn" under the lock and calls onChange if "fn" added revisions.
+func (m *MemoryRepository) write(fn func() error) error {
+	m.mu.Lock()
+	lastEventID := m.lastEventID
+	err := fn()
+	changed := m.lastEventID != lastEventID
+	m.mu.Unlock()
+
+	if changed && m.onChange != nil {
+		m.onChange()
+	}
+
+	return err
+}
+
+// find returns copies of the offers matching "match" in no particular order.
+func (m *MemoryRepository) find(match func(offer *models.Offer) bool) []models.Offer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	offers := make([]models.Offer, 0)
+
+	for _, stored := range m.offers {
+		if match(&stored.offer) {
+			offers = append(offers, *copyOffer(&stored.offer))
+		}
+	}
+
+	return offers
+}
+
+// liveOffer returns the stored offer, ErrNotFound if it is missing or removed.
+func (m *MemoryRepository) liveOffer(offerID uint64) (*memoryOffer, error) {
+	stored, ok := m.offers[offerID]
+	if !ok || stored.offer.IsDeleted {
+		return nil, notFound(offerID, nil)
+	}
+
+	return stored, nil
+}
+
+// checkConflicts returns ErrAlreadyExists if the fail policy doesn't allow to create all offers,
+// ErrConflict if a batch repeats the user and team with the update-grade policy.
+func (m *MemoryRepository) checkConflicts(
+	offers []models.Offer,
+	batches [][]models.Offer,
+	policy models.ConflictPolicy,
+) error {
+	for _, batch := range batches {
+		if err := checkRepeatedKeys(batch, policy); err != nil {
+			return err
+		}
+	}
+
+	if policy == models.ConflictPolicySkip || policy == models.ConflictPolicyUpdateGrade {
+		return nil
+	}
+
+	keys := make(map[userTeam]bool, len(offers))
+
+	for _, offer := range offers {
+		key := userTeam{offer.UserID, offer.TeamID}
+		if _, ok := m.live[key]; ok || keys[key] {
+			return alreadyExists(nil)
+		}
+
+		keys[key] = true
+	}
+
+	return nil
+}
+
+// create inserts the offer or applies the conflict policy to the live offer of the same user and team.
+func (m *MemoryRepository) create(
+	ctx context.Context,
+	offer *models.Offer,
+	policy models.ConflictPolicy,
+) (models.CreateResult, error) {
+	key := userTeam{offer.UserID, offer.TeamID}
+
+	if id, ok := m.live[key]; ok {
+		existing := &m.offers[id].offer
+
+		switch {
+		case policy == models.ConflictPolicySkip,
+			policy == models.ConflictPolicyUpdateGrade && existing.Grade == offer.Grade:
+			return models.CreateResult{ID: id, Action: models.CreateActionSkipped}, nil
+		case policy == models.ConflictPolicyUpdateGrade:
+			before := *existing
+			existing.Grade = offer.Grade
+			m.update(existing)
+			m.appendHistory(ctx, &before, existing, models.OfferHistoryActionUpdated)
+
+			return models.CreateResult{ID: id, Action: models.CreateActionUpdated}, nil
+		default:
+			return models.CreateResult{}, alreadyExists(nil)
+		}
+	}
+
+	now := memoryNow()
+	m.lastOfferID++
+
+	created := models.Offer{
+		ID:        m.lastOfferID,
+		UserID:    offer.UserID,
+		TeamID:    offer.TeamID,
+		Grade:     offer.Grade,
+		ExpiresAt: offer.ExpiresAt,
+		Status:    models.OfferStatusDraft,
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	m.offers[created.ID] = &memoryOffer{offer: *copyOffer(&created)}
+	m.live[key] = created.ID
+	m.appendHistory(ctx, nil, &created, models.OfferHistoryActionCreated)
+
+	return models.CreateResult{ID: created.ID, Action: models.CreateActionInserted}, nil
+}
+
+// update sets the columns changed by every UPDATE of Repository.
+func (m *MemoryRepository) update(offer *models.Offer) {
+	offer.Version++
+	offer.UpdatedAt = memoryNow()
+}
+
+// appendHistory appends the next revision of the offer "after".
+func (m *MemoryRepository) appendHistory(ctx context.Context, before, after *models.Offer, action models.OfferHistoryAction) {
+	m.lastEventID++
+	m.revisions[after.ID]++
+
+	revision := models.OfferRevision{
+		ID:        m.lastEventID,
+		OfferID:   after.ID,
+		Revision:  m.revisions[after.ID],
+		Action:    action,
+		Actor:     models.ActorFromContext(ctx),
+		CreatedAt: memoryNow(),
+		Changes:   models.DiffOffers(before, after),
+	}
+	m.history = append(m.history, models.OfferEvent{
+		OfferRevision: revision,
+		Position:      m.lastEventID,
+		Offer:         *copyOffer(after),
+	})
+
+	message := models.NewOfferEventMessage(&models.OfferEvent{OfferRevision: revision, Offer: *copyOffer(after)})
+	m.lastOutboxID++
+	message.ID = m.lastOutboxID
+	message.CreatedAt = revision.CreatedAt
+	m.outbox = append(m.outbox, &memoryOutboxMessage{message: message})
+}
+
+// memoryNow - the current time with the precision of Postgres timestamps.
+func memoryNow() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// copyOffer copies the offer, the stored offers are not shared with the callers.
+func copyOffer(offer *models.Offer) *models.Offer {
+	result := *offer
+
+	if offer.ExpiresAt != nil {
+		expiresAt := *offer.ExpiresAt
+		result.ExpiresAt = &expiresAt
+	}
+
+	return &result
+}
@@ -0,0 +1,155 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+	"github.com/ozoncp/ocp-offer-api/internal/repo/repotest"
+)
+
+func TestMemoryRepository(t *testing.T) {
+	t.Parallel()
+	repotest.Run(t, func(t *testing.T, batchSize uint) repo.IRepository {
+		return repo.NewMemoryRepo(batchSize, nil)
+	})
+}
+
+func TestMemoryRepositoryOnChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	changes := 0
+	r := repo.NewMemoryRepo(10, func() { changes++ })
+
+	result, err := r.CreateOffer(ctx, models.Offer{UserID: 1, TeamID: 2, Grade: 3}, models.ConflictPolicyFail)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changes)
+
+	// Failed and read-only calls are not changes
+	_, err = r.CreateOffer(ctx, models.Offer{UserID: 1, TeamID: 2, Grade: 3}, models.ConflictPolicyFail)
+	assert.ErrorIs(t, err, repo.ErrAlreadyExists)
+	_, err = r.DescribeOffer(ctx, result.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changes)
+
+	require.NoError(t, r.RemoveOffer(ctx, result.ID, 0))
+	assert.Equal(t, 2, changes)
+}
+
+func TestMemoryRepositoryCopies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := repo.NewMemoryRepo(10, nil)
+	expiresAt := time.Now().Add(time.Hour)
+
+	result, err := r.CreateOffer(ctx, models.Offer{UserID: 1, TeamID: 2, Grade: 3, ExpiresAt: &expiresAt}, models.ConflictPolicyFail)
+	require.NoError(t, err)
+
+	offer, err := r.DescribeOffer(ctx, result.ID)
+	require.NoError(t, err)
+
+	// Changes of the returned offer don't reach the repository
+	offer.Grade = 10
+	*offer.ExpiresAt = expiresAt.Add(time.Hour)
+
+	stored, err := r.DescribeOffer(ctx, result.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), stored.Grade)
+	assert.True(t, stored.ExpiresAt.Equal(expiresAt))
+}
+
+func TestMemoryRepositoryExpireOffers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := repo.NewMemoryRepo(10, nil)
+	now := time.Now()
+
+	ids := make([]uint64, 0, 3)
+	for i, hours := range []int{-1, -3, 1} {
+		expiresAt := now.Add(time.Duration(hours) * time.Hour)
+		result, err := r.CreateOffer(ctx, models.Offer{
+			UserID:    uint64(i + 1),
+			TeamID:    1,
+			Grade:     1,
+			ExpiresAt: &expiresAt,
+		}, models.ConflictPolicyFail)
+		require.NoError(t, err)
+		require.NoError(t, r.UpdateOfferStatus(ctx, result.ID, models.OfferStatusDraft, models.OfferStatusSent))
+
+		ids = append(ids, result.ID)
+	}
+
+	// The offer expired first goes first
+	count, err := r.ExpireOffers(ctx, now, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+
+	offer, err := r.DescribeOffer(ctx, ids[1])
+	require.NoError(t, err)
+	assert.Equal(t, models.OfferStatusExpired, offer.Status)
+
+	count, err = r.ExpireOffers(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+
+	offer, err = r.DescribeOffer(ctx, ids[2])
+	require.NoError(t, err)
+	assert.Equal(t, models.OfferStatusSent, offer.Status)
+}
+
+func TestMemoryRepositoryPurgeOffers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := repo.NewMemoryRepo(10, nil)
+
+	result, err := r.CreateOffer(ctx, models.Offer{UserID: 1, TeamID: 2, Grade: 3}, models.ConflictPolicyFail)
+	require.NoError(t, err)
+	require.NoError(t, r.RemoveOffer(ctx, result.ID, 0))
+
+	count, err := r.PurgeOffers(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	count, err = r.PurgeOffers(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+
+	// The history outlives the offer
+	history, err := r.GetOfferHistory(ctx, result.ID)
+	require.NoError(t, err)
+	assert.Len(t, history, 2)
+
+	assert.ErrorIs(t, r.RestoreOffer(ctx, result.ID), repo.ErrNotFound)
+}
+
+func TestMemoryRepositoryGetOfferStats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := repo.NewMemoryRepo(10, nil)
+
+	for _, offer := range []models.Offer{
+		{UserID: 1, TeamID: 1, Grade: 3},
+		{UserID: 2, TeamID: 1, Grade: 5},
+		{UserID: 3, TeamID: 2, Grade: 3},
+	} {
+		_, err := r.CreateOffer(ctx, offer, models.ConflictPolicyFail)
+		require.NoError(t, err)
+	}
+
+	groups, err := r.GetOfferStats(ctx, []models.OfferStatsDimension{models.OfferStatsDimensionTeam}, models.OfferStatsFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []models.OfferStatsGroup{{TeamID: 1, Count: 2}, {TeamID: 2, Count: 1}}, groups)
+
+	_, err = r.GetOfferStats(ctx, []models.OfferStatsDimension{models.OfferStatsDimensionUnspecified}, models.OfferStatsFilter{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,176 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+)
+
+// OutboxPublisher publishes the messages in their order and returns the number of published ones,
+// an error means that the messages after them were not published.
+type OutboxPublisher func(messages []models.OutboxMessage) (uint64, error)
+
+// RelayOutbox passes up to "limit" unsent messages in the order of ids to "publish" and returns
+// the number of published messages. They are marked sent, and if publishing fails the attempt of the next
+// message is counted and the error of "publish" is returned.
+// The messages stay locked until they are marked, so concurrent relays neither publish them twice
+// nor change their order. A message is published again if the marking fails.
+func (r *Repository) RelayOutbox(ctx context.Context, limit uint64, publish OutboxPublisher) (uint64, error) {
+	var (
+		sent       uint64
+		publishErr error
+	)
+
+	err := r.withTx(ctx, func(tx *sqlx.Tx) error {
+		rows, err := sq.
+			Select("id", "type", "key", "payload", "attempts", "created_at").
+			From("outbox").
+			Where(sq.Eq{"sent_at": nil}).
+			OrderBy("id ASC").
+			Limit(limit).
+			Suffix("FOR UPDATE").
+			RunWith(tx).
+			PlaceholderFormat(sq.Dollar).
+			QueryContext(ctx)
+
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		messages := make([]models.OutboxMessage, 0)
+		for rows.Next() {
+			var message models.OutboxMessage
+			if err := rows.Scan(
+				&message.ID,
+				&message.Type,
+				&message.Key,
+				&message.Payload,
+				&message.Attempts,
+				&message.CreatedAt,
+			); err != nil {
+				return err
+			}
+
+			messages = append(messages, message)
+		}
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		sent, publishErr = publish(messages)
+
+		if sent > 0 {
+			ids := make([]uint64, 0, sent)
+			for _, message := range messages[:sent] {
+				ids = append(ids, message.ID)
+			}
+
+			if _, err := sq.
+				Update("outbox").
+				Set("sent_at", sq.Expr("NOW()")).
+				Where(sq.Eq{"id": ids}).
+				RunWith(tx).
+				PlaceholderFormat(sq.Dollar).
+				ExecContext(ctx); err != nil {
+				return err
+			}
+		}
+
+		if publishErr != nil && sent < uint64(len(messages)) {
+			if _, err := sq.
+				Update("outbox").
+				Set("attempts", sq.Expr("attempts + 1")).
+				Set("last_error", publishErr.Error()).
+				Where(sq.Eq{"id": messages[sent].ID}).
+				RunWith(tx).
+				PlaceholderFormat(sq.Dollar).
+				ExecContext(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return sent, publishErr
+}
+
+// GetOutboxStats returns the number of unsent messages and the moment the oldest of them was written.
+func (r *Repository) GetOutboxStats(ctx context.Context) (models.OutboxStats, error) {
+	var stats models.OutboxStats
+
+	err := sq.
+		Select("COUNT(*)", "MIN(created_at)").
+		From("outbox").
+		Where(sq.Eq{"sent_at": nil}).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar).
+		QueryRowContext(ctx).
+		Scan(&stats.Pending, &stats.OldestCreatedAt)
+
+	return stats, err
+}
+
+// PurgeOutbox deletes messages sent before "sentBefore" and returns their number.
+func (r *Repository) PurgeOutbox(ctx context.Context, sentBefore time.Time) (uint64, error) {
+	result, err := sq.
+		Delete("outbox").
+		Where(sq.Lt{"sent_at": sentBefore}).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar).
+		ExecContext(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(rowsAffected), nil
+}
+
+// insertOutbox puts the revision together with the current state of the offer to the outbox.
+func insertOutbox(ctx context.Context, tx *sqlx.Tx, revision *models.OfferRevision) error {
+	event := models.OfferEvent{OfferRevision: *revision}
+
+	row := sq.
+		Select(offerColumns...).
+		From("offer").
+		Where(sq.Eq{"id": revision.OfferID}).
+		RunWith(tx).
+		PlaceholderFormat(sq.Dollar).
+		QueryRowContext(ctx)
+
+	if err := scanOffer(row, &event.Offer); err != nil {
+		return err
+	}
+
+	message := models.NewOfferEventMessage(&event)
+
+	_, err := sq.
+		Insert("outbox").
+		Columns("type", "key", "payload").
+		Values(message.Type, message.Key, message.Payload).
+		RunWith(tx).
+		PlaceholderFormat(sq.Dollar).
+		ExecContext(ctx)
+
+	return err
+}
@@ -2,11 +2,15 @@ package repo
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 	"unsafe"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/opentracing/opentracing-go"
 
 	"github.com/ozoncp/ocp-offer-api/internal/models"
@@ -14,12 +18,79 @@ import (
 )
 
 type IRepository interface {
-	MultiCreateOffer(ctx context.Context, offers []models.Offer) (uint64, error)
-	CreateOffer(ctx context.Context, offer models.Offer) (uint64, error)
+	MultiCreateOffer(
+		ctx context.Context,
+		offers []models.Offer,
+		policy models.ConflictPolicy,
+		bestEffort bool,
+	) ([]models.CreateResult, error)
+	CreateOffer(ctx context.Context, offer models.Offer, policy models.ConflictPolicy) (models.CreateResult, error)
+	// UpdateOffer checks offer.Version against the stored version unless it is 0.
 	UpdateOffer(ctx context.Context, offer models.Offer) error
+	// PatchOffer is UpdateOffer limited to the fields from models.OfferPatchFields.
+	PatchOffer(ctx context.Context, offer models.Offer, fields []string) error
+	UpdateOfferStatus(ctx context.Context, offerID uint64, from, to models.OfferStatus) error
 	DescribeOffer(ctx context.Context, offerID uint64) (*models.Offer, error)
-	ListOffer(ctx context.Context, pagination models.PaginationInput) ([]models.Offer, *models.PaginationInfo, error)
-	RemoveOffer(ctx context.Context, offerID uint64) error
+	BatchGetOffers(ctx context.Context, offerIDs []uint64) ([]models.Offer, error)
+	ListOffer(
+		ctx context.Context,
+		pagination models.PaginationInput,
+		filter models.OfferFilter,
+		order models.OfferOrder,
+	) ([]models.Offer, *models.PaginationInfo, error)
+	// RemoveOffer returns ErrNotFound for a missing or already removed offer.
+	RemoveOffer(ctx context.Context, offerID uint64, expectedVersion uint64) error
+	RestoreOffer(ctx context.Context, offerID uint64) error
+	PurgeOffers(ctx context.Context, deletedBefore time.Time) (uint64, error)
+	ExpireOffers(ctx context.Context, now time.Time, limit uint64) (uint64, error)
+	GetOfferHistory(ctx context.Context, offerID uint64) ([]models.OfferRevision, error)
+	ListOfferEvents(
+		ctx context.Context,
+		afterPosition uint64,
+		filter models.OfferFilter,
+		limit uint64,
+	) ([]models.OfferEvent, error)
+	LastOfferEventPosition(ctx context.Context) (uint64, error)
+	ExportOffers(ctx context.Context, filter models.OfferFilter, fn func(offer *models.Offer) error) error
+	GetOfferStats(
+		ctx context.Context,
+		groupBy []models.OfferStatsDimension,
+		filter models.OfferStatsFilter,
+	) ([]models.OfferStatsGroup, error)
+	// RelayOutbox hands the unsent messages of the outbox to "publish" and marks the published ones sent.
+	RelayOutbox(ctx context.Context, limit uint64, publish OutboxPublisher) (uint64, error)
+	GetOutboxStats(ctx context.Context) (models.OutboxStats, error)
+	PurgeOutbox(ctx context.Context, sentBefore time.Time) (uint64, error)
+	CreateTask(ctx context.Context, task *models.Task) error
+	StartTaskPart(ctx context.Context, taskID string) error
+	FinishTaskPart(ctx context.Context, taskID string, offerIDs []uint64, taskErr error) error
+	// GetTask returns ErrNotFound for an unknown task.
+	GetTask(ctx context.Context, taskID string) (*models.Task, error)
+}
+
+// offerColumns - columns of the offer table in the order expected by scanOffer.
+var offerColumns = []string{
+	"id", "user_id", "team_id", "grade", "status", "expires_at", "is_deleted", "version", "created_at", "updated_at",
+}
+
+func scanOffer(row sq.RowScanner, offer *models.Offer) error {
+	return row.Scan(offerFields(offer)...)
+}
+
+// offerFields - scan destinations for offerColumns.
+func offerFields(offer *models.Offer) []interface{} {
+	return []interface{}{
+		&offer.ID,
+		&offer.UserID,
+		&offer.TeamID,
+		&offer.Grade,
+		&offer.Status,
+		&offer.ExpiresAt,
+		&offer.IsDeleted,
+		&offer.Version,
+		&offer.CreatedAt,
+		&offer.UpdatedAt,
+	}
 }
 
 type Repository struct {
@@ -31,86 +102,181 @@ func NewRepo(db *sqlx.DB, batchSize uint) IRepository {
 	return &Repository{db: db, batchSize: batchSize}
 }
 
-func (r *Repository) MultiCreateOffer(ctx context.Context, offers []models.Offer) (uint64, error) {
+// MultiCreateOffer creates the offers in one transaction and returns the results in the order of the offers.
+// By default a failed offer rolls back all of them and its error is returned.
+// In the best effort mode every offer is inserted under a savepoint, so a failed offer is rolled back alone
+// and its error is put to its result, the returned error then means that the transaction itself failed.
+func (r *Repository) MultiCreateOffer(
+	ctx context.Context,
+	offers []models.Offer,
+	policy models.ConflictPolicy,
+	bestEffort bool,
+) ([]models.CreateResult, error) {
 	tracer := opentracing.GlobalTracer()
 	span := tracer.StartSpan("MultiCreateOffer global")
 	defer span.Finish()
 
-	var countCreated uint64
+	results := make([]models.CreateResult, len(offers))
+
+	if bestEffort {
+		if err := r.withTx(ctx, func(tx *sqlx.Tx) error {
+			return createEach(ctx, tx, offers, policy, results)
+		}); err != nil {
+			return nil, err
+		}
+
+		return results, nil
+	}
+
 	batches, err := utils.SplitOffersToBatches(offers, r.batchSize)
 	if err != nil {
-		return countCreated, err
+		return nil, err
 	}
 
-	for index, batch := range batches {
-		childSpan := tracer.StartSpan(
-			fmt.Sprintf("MultiCreateOffer for chunk %d, count of bytes: %d", index, len(batch)*int(unsafe.Sizeof(models.Offer{}))),
-			opentracing.ChildOf(span.Context()),
-		)
-		defer childSpan.Finish()
+	err = r.withTx(ctx, func(tx *sqlx.Tx) error {
+		offset := 0
 
-		query := sq.
-			Insert("offer").
-			Columns("user_id", "team_id", "grade").
-			RunWith(r.db).
-			PlaceholderFormat(sq.Dollar)
+		for index, batch := range batches {
+			childSpan := tracer.StartSpan(
+				fmt.Sprintf("MultiCreateOffer for chunk %d, count of bytes: %d", index, len(batch)*int(unsafe.Sizeof(models.Offer{}))),
+				opentracing.ChildOf(span.Context()),
+			)
 
-		for _, offer := range batch {
-			query = query.Values(offer.UserID, offer.TeamID, offer.Grade)
+			err := createBatch(ctx, tx, batch, policy, results[offset:offset+len(batch)])
+			childSpan.Finish()
+
+			if err != nil {
+				return err
+			}
+
+			offset += len(batch)
 		}
 
-		result, err := query.ExecContext(ctx)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *Repository) CreateOffer(
+	ctx context.Context,
+	offer models.Offer,
+	policy models.ConflictPolicy,
+) (models.CreateResult, error) {
+	results := make([]models.CreateResult, 1)
 
+	err := r.withTx(ctx, func(tx *sqlx.Tx) error {
+		return createBatch(ctx, tx, []models.Offer{offer}, policy, results)
+	})
+
+	if err != nil {
+		return models.CreateResult{}, err
+	}
+
+	return results[0], nil
+}
+
+func (r *Repository) UpdateOffer(ctx context.Context, offer models.Offer) error {
+	return r.PatchOffer(ctx, offer, models.OfferPatchFields)
+}
+
+func (r *Repository) PatchOffer(ctx context.Context, offer models.Offer, fields []string) error {
+	return r.withTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockOffer(ctx, tx, offer.ID)
 		if err != nil {
-			return countCreated, err
+			return err
 		}
 
-		rowsAffected, err := result.RowsAffected()
+		if before.IsDeleted {
+			return notFound(offer.ID, nil)
+		}
+
+		if err := checkVersion(before, offer.Version); err != nil {
+			return err
+		}
+
+		after := *before
+		if err := after.ApplyFields(&offer, fields); err != nil {
+			return err
+		}
+
+		result, err := sq.
+			Update("offer").
+			Set("user_id", after.UserID).
+			Set("team_id", after.TeamID).
+			Set("grade", after.Grade).
+			Set("expires_at", after.ExpiresAt).
+			Set("version", sq.Expr("version + 1")).
+			Set("updated_at", sq.Expr("NOW()")).
+			Where(sq.Eq{"id": offer.ID, "is_deleted": false}).
+			RunWith(tx).
+			PlaceholderFormat(sq.Dollar).
+			ExecContext(ctx)
 
 		if err != nil {
-			return countCreated, err
+			return offerError(offer.ID, err)
 		}
 
-		countCreated += uint64(rowsAffected)
-	}
+		if err := checkAffected(result, offer.ID); err != nil {
+			return err
+		}
 
-	return countCreated, nil
+		return insertHistory(ctx, tx, offer.ID,
+			models.OfferHistoryActionUpdated, models.DiffOffers(before, &after))
+	})
 }
 
-func (r *Repository) CreateOffer(ctx context.Context, offer models.Offer) (uint64, error) {
-	query := sq.
-		Insert("offer").
-		Columns("user_id", "team_id", "grade").
-		Values(offer.UserID, offer.TeamID, offer.Grade).
-		Suffix("RETURNING id").
-		RunWith(r.db).
-		PlaceholderFormat(sq.Dollar)
+// UpdateOfferStatus moves the offer from the "from" status to the "to" status.
+// The offer row is locked and its status is checked again, so a concurrent change
+// of the offer results in models.ErrInvalidStatusTransition instead of a lost update.
+func (r *Repository) UpdateOfferStatus(ctx context.Context, offerID uint64, from, to models.OfferStatus) error {
+	return r.withTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockOffer(ctx, tx, offerID)
+		if err != nil {
+			return err
+		}
 
-	var offerID uint64
-	if err := query.QueryRowContext(ctx).Scan(&offerID); err != nil {
-		return 0, err
-	}
+		if before.IsDeleted {
+			return notFound(offerID, nil)
+		}
 
-	return offerID, nil
-}
+		if before.Status != from {
+			return conflict(models.ErrInvalidStatusTransition)
+		}
 
-func (r *Repository) UpdateOffer(ctx context.Context, offer models.Offer) error {
-	_, err := sq.
-		Update("offer").
-		Set("user_id", offer.UserID).
-		Set("team_id", offer.TeamID).
-		Set("grade", offer.Grade).
-		Where(sq.Eq{"id": offer.ID}).
-		RunWith(r.db).
-		PlaceholderFormat(sq.Dollar).
-		ExecContext(ctx)
+		result, err := sq.
+			Update("offer").
+			Set("status", to).
+			Set("version", sq.Expr("version + 1")).
+			Set("updated_at", sq.Expr("NOW()")).
+			Where(sq.Eq{"id": offerID, "is_deleted": false}).
+			RunWith(tx).
+			PlaceholderFormat(sq.Dollar).
+			ExecContext(ctx)
 
-	return err
+		if err != nil {
+			return err
+		}
+
+		if err := checkAffected(result, offerID); err != nil {
+			return err
+		}
+
+		after := *before
+		after.Status = to
+
+		return insertHistory(ctx, tx, offerID,
+			models.OfferHistoryActionUpdated, models.DiffOffers(before, &after))
+	})
 }
 
 func (r *Repository) DescribeOffer(ctx context.Context, offerID uint64) (*models.Offer, error) {
 	query := sq.
-		Select("id", "user_id", "team_id", "grade").
+		Select(offerColumns...).
 		From("offer").
 		Where(sq.And{
 			sq.Eq{"id": offerID},
@@ -121,74 +287,575 @@ func (r *Repository) DescribeOffer(ctx context.Context, offerID uint64) (*models
 
 	var offer models.Offer
 
-	err := query.QueryRowContext(ctx).
-		Scan(&offer.ID, &offer.UserID, &offer.TeamID, &offer.Grade)
-
-	if err != nil {
-		return nil, err
+	if err := scanOffer(query.QueryRowContext(ctx), &offer); err != nil {
+		return nil, offerError(offerID, err)
 	}
 
 	return &offer, nil
 }
 
-func (r *Repository) ListOffer(ctx context.Context, pagination models.PaginationInput) ([]models.Offer, *models.PaginationInfo, error) {
+// BatchGetOffers returns the found offers in no particular order, removed offers are not returned.
+func (r *Repository) BatchGetOffers(ctx context.Context, offerIDs []uint64) ([]models.Offer, error) {
 	query := sq.
-		Select("id", "user_id", "team_id", "grade").
+		Select(offerColumns...).
 		From("offer").
-		Limit(uint64(pagination.Take)).
-		Offset(pagination.Skip).
-		OrderBy("id ASC").
-		Where(sq.Eq{"is_deleted": false}).
+		Where(sq.And{
+			sq.Expr("id = ANY(?)", pq.Array(offerIDs)),
+			sq.Eq{"is_deleted": false},
+		}).
 		RunWith(r.db).
 		PlaceholderFormat(sq.Dollar)
 
-	rows, err := query.QueryContext(ctx)
+	return queryOffers(ctx, query)
+}
 
-	if err != nil {
+// ListOffer returns a page of offers in the given order.
+// With a page token the page starts after the token instead of skipping rows, which stays fast on deep pages.
+func (r *Repository) ListOffer(
+	ctx context.Context,
+	pagination models.PaginationInput,
+	filter models.OfferFilter,
+	order models.OfferOrder,
+) ([]models.Offer, *models.PaginationInfo, error) {
+	// The order field is a part of the query text, so only known fields are allowed
+	if err := order.Validate(); err != nil {
 		return nil, nil, err
 	}
-	defer rows.Close()
 
-	offers := make([]models.Offer, 0)
-	for rows.Next() {
-		var offer models.Offer
-		if err := rows.Scan(
-			&offer.ID,
-			&offer.UserID,
-			&offer.TeamID,
-			&offer.Grade,
-		); err != nil {
+	where := filterCondition(filter)
+
+	pageWhere := where
+	if pagination.PageToken != nil {
+		after, err := afterCondition(order, pagination.PageToken)
+		if err != nil {
 			return nil, nil, err
 		}
-		offers = append(offers, offer)
+
+		pageWhere = append(sq.And{after}, where...)
 	}
 
-	var totalItems uint64
-	if err := sq.
-		Select("COUNT(*)").
+	direction := "ASC"
+	if order.Desc {
+		direction = "DESC"
+	}
+
+	orderBy := []string{"id " + direction}
+	if order.Field != "id" {
+		orderBy = append([]string{order.Field + " " + direction}, orderBy...)
+	}
+
+	// One extra row tells whether there is a next page
+	query := sq.
+		Select(offerColumns...).
 		From("offer").
-		Where(sq.Eq{"is_deleted": false}).
+		Limit(uint64(pagination.Take) + 1).
+		Offset(pagination.Skip).
+		OrderBy(orderBy...).
+		Where(pageWhere).
 		RunWith(r.db).
-		PlaceholderFormat(sq.Dollar).
-		QueryRowContext(ctx).Scan(&totalItems); err != nil {
+		PlaceholderFormat(sq.Dollar)
+
+	offers, err := queryOffers(ctx, query)
+	if err != nil {
 		return nil, nil, err
 	}
 
+	var next *models.PageToken
+	if len(offers) > int(pagination.Take) {
+		offers = offers[:pagination.Take]
+		next = models.NewPageToken(order, &offers[len(offers)-1])
+	}
+
+	var totalItems uint64
+	if !pagination.SkipCount {
+		if err := sq.
+			Select("COUNT(*)").
+			From("offer").
+			Where(where).
+			RunWith(r.db).
+			PlaceholderFormat(sq.Dollar).
+			QueryRowContext(ctx).Scan(&totalItems); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	perPage := uint32(len(offers))
 
-	pagInfo := pagination.GetPaginationInfo(perPage, totalItems)
+	pagInfo := pagination.GetPaginationInfo(perPage, totalItems, next)
 
 	return offers, pagInfo, nil
 }
 
-func (r *Repository) RemoveOffer(ctx context.Context, offerID uint64) error {
-	_, err := sq.
-		Update("offer").
-		Set("is_deleted", true).
-		Where(sq.Eq{"id": offerID}).
+func (r *Repository) RemoveOffer(ctx context.Context, offerID uint64, expectedVersion uint64) error {
+	return r.withTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockOffer(ctx, tx, offerID)
+		if err != nil {
+			return err
+		}
+
+		if before.IsDeleted {
+			return notFound(offerID, nil)
+		}
+
+		if err := checkVersion(before, expectedVersion); err != nil {
+			return err
+		}
+
+		result, err := sq.
+			Update("offer").
+			Set("is_deleted", true).
+			Set("deleted_at", sq.Expr("NOW()")).
+			Set("version", sq.Expr("version + 1")).
+			Set("updated_at", sq.Expr("NOW()")).
+			Where(sq.Eq{"id": offerID, "is_deleted": false}).
+			RunWith(tx).
+			PlaceholderFormat(sq.Dollar).
+			ExecContext(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		if err := checkAffected(result, offerID); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsDeleted = true
+
+		return insertHistory(ctx, tx, offerID,
+			models.OfferHistoryActionRemoved, models.DiffOffers(before, &after))
+	})
+}
+
+// RestoreOffer brings back a removed offer, models.ErrOfferNotRemoved is returned for a live one
+// and ErrAlreadyExists if another live offer of the same user and team exists.
+func (r *Repository) RestoreOffer(ctx context.Context, offerID uint64) error {
+	return r.withTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockOffer(ctx, tx, offerID)
+		if err != nil {
+			return err
+		}
+
+		if !before.IsDeleted {
+			return conflict(models.ErrOfferNotRemoved)
+		}
+
+		_, err = sq.
+			Update("offer").
+			Set("is_deleted", false).
+			Set("deleted_at", nil).
+			Set("version", sq.Expr("version + 1")).
+			Set("updated_at", sq.Expr("NOW()")).
+			Where(sq.Eq{"id": offerID}).
+			RunWith(tx).
+			PlaceholderFormat(sq.Dollar).
+			ExecContext(ctx)
+
+		if err != nil {
+			// A live offer of the same user and team was created after the removal
+			return offerError(offerID, err)
+		}
+
+		after := *before
+		after.IsDeleted = false
+
+		return insertHistory(ctx, tx, offerID,
+			models.OfferHistoryActionRestored, models.DiffOffers(before, &after))
+	})
+}
+
+// PurgeOffers permanently deletes offers removed before "deletedBefore" and returns their number.
+// The history of the purged offers is kept, so the event stream still has their revisions.
+func (r *Repository) PurgeOffers(ctx context.Context, deletedBefore time.Time) (uint64, error) {
+	result, err := sq.
+		Delete("offer").
+		Where(sq.And{
+			sq.Eq{"is_deleted": true},
+			sq.Lt{"deleted_at": deletedBefore},
+		}).
 		RunWith(r.db).
 		PlaceholderFormat(sq.Dollar).
 		ExecContext(ctx)
 
-	return err
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(rowsAffected), nil
+}
+
+// ExpireOffers moves up to "limit" sent offers whose expiration moment is not later than "now"
+// to the expired status and returns the number of expired offers.
+// Rows locked by another worker are skipped, so several instances can run the expiration at once.
+func (r *Repository) ExpireOffers(ctx context.Context, now time.Time, limit uint64) (uint64, error) {
+	overdue := sq.
+		Select("id").
+		From("offer").
+		Where(sq.And{
+			sq.Eq{"status": models.OfferStatusSent},
+			sq.Eq{"is_deleted": false},
+			sq.LtOrEq{"expires_at": now},
+		}).
+		OrderBy("expires_at ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query := sq.
+		Update("offer").
+		Set("status", models.OfferStatusExpired).
+		Set("version", sq.Expr("version + 1")).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Expr("id IN (?)", overdue)).
+		Suffix("RETURNING " + strings.Join(offerColumns, ", ")).
+		PlaceholderFormat(sq.Dollar)
+
+	var countExpired uint64
+
+	err := r.withTx(ctx, func(tx *sqlx.Tx) error {
+		expired, err := queryOffers(ctx, query.RunWith(tx))
+		if err != nil {
+			return err
+		}
+
+		for i := range expired {
+			before := expired[i]
+			before.Status = models.OfferStatusSent
+
+			if err := insertHistory(ctx, tx, expired[i].ID,
+				models.OfferHistoryActionUpdated, models.DiffOffers(&before, &expired[i])); err != nil {
+				return err
+			}
+		}
+
+		countExpired = uint64(len(expired))
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return countExpired, nil
+}
+
+// ----------------------------------------------------------------
+
+// withTx runs "fn" in a transaction, which is committed if "fn" succeeds and rolled back otherwise.
+func (r *Repository) withTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// liveOfferConflict - conflict target of the partial unique index "offer.live_user_team_id_index".
+// Removed offers are not covered, so the same user and team can get a new offer after the removal.
+const liveOfferConflict = "ON CONFLICT (user_id, team_id) WHERE is_deleted = FALSE"
+
+// userTeam - the unique key of a live offer.
+type userTeam struct {
+	userID, teamID uint64
+}
+
+// checkRepeatedKeys rejects a batch repeating the user and team with the update-grade policy,
+// as one statement can't update the same offer twice.
+func checkRepeatedKeys(batch []models.Offer, policy models.ConflictPolicy) error {
+	if policy != models.ConflictPolicyUpdateGrade {
+		return nil
+	}
+
+	keys := make(map[userTeam]bool, len(batch))
+
+	for _, offer := range batch {
+		key := userTeam{offer.UserID, offer.TeamID}
+		if keys[key] {
+			return repeatedKey(key)
+		}
+
+		keys[key] = true
+	}
+
+	return nil
+}
+
+// createBatch inserts the batch with one statement and puts the results to "results" in the order of the batch.
+// With the skip and update-grade policies the existing offers of the batch are locked first,
+// so their state before the change is known for the history.
+func createBatch(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	batch []models.Offer,
+	policy models.ConflictPolicy,
+	results []models.CreateResult,
+) error {
+	if err := checkRepeatedKeys(batch, policy); err != nil {
+		return err
+	}
+
+	existing := make(map[userTeam]*models.Offer)
+
+	if policy == models.ConflictPolicySkip || policy == models.ConflictPolicyUpdateGrade {
+		keys := sq.Or{}
+		for _, offer := range batch {
+			keys = append(keys, sq.Eq{"user_id": offer.UserID, "team_id": offer.TeamID})
+		}
+
+		locked, err := queryOffers(ctx, sq.
+			Select(offerColumns...).
+			From("offer").
+			Where(sq.And{keys, sq.Eq{"is_deleted": false}}).
+			Suffix("FOR UPDATE").
+			RunWith(tx).
+			PlaceholderFormat(sq.Dollar))
+
+		if err != nil {
+			return err
+		}
+
+		for i := range locked {
+			existing[userTeam{locked[i].UserID, locked[i].TeamID}] = &locked[i]
+		}
+	}
+
+	query := sq.
+		Insert("offer").
+		Columns("user_id", "team_id", "grade", "expires_at").
+		PlaceholderFormat(sq.Dollar)
+
+	for _, offer := range batch {
+		query = query.Values(offer.UserID, offer.TeamID, offer.Grade, offer.ExpiresAt)
+	}
+
+	switch policy {
+	case models.ConflictPolicySkip:
+		query = query.Suffix(liveOfferConflict + " DO NOTHING")
+	case models.ConflictPolicyUpdateGrade:
+		// An offer with the same grade is not changed and is reported as skipped
+		query = query.Suffix(liveOfferConflict + " DO UPDATE " +
+			"SET grade = EXCLUDED.grade, version = offer.version + 1, updated_at = NOW() " +
+			"WHERE offer.grade <> EXCLUDED.grade")
+	}
+
+	// xmax is 0 only for the inserted rows
+	rows, err := query.
+		Suffix("RETURNING " + strings.Join(offerColumns, ", ") + ", xmax = 0").
+		RunWith(tx).
+		QueryContext(ctx)
+
+	if err != nil {
+		return offerError(0, err)
+	}
+	defer rows.Close()
+
+	type written struct {
+		offer    models.Offer
+		inserted bool
+		reported bool // The result and the history are written, the batch may repeat the user and team
+	}
+
+	// The order of the returned rows is not guaranteed, so they are matched by the unique user and team
+	changed := make(map[userTeam]*written, len(batch))
+
+	for rows.Next() {
+		var w written
+		if err := rows.Scan(append(offerFields(&w.offer), &w.inserted)...); err != nil {
+			return err
+		}
+		changed[userTeam{w.offer.UserID, w.offer.TeamID}] = &w
+	}
+
+	if err := rows.Err(); err != nil {
+		return offerError(0, err)
+	}
+
+	for i := range batch {
+		key := userTeam{batch[i].UserID, batch[i].TeamID}
+
+		w, ok := changed[key]
+		switch {
+		case ok && w.reported:
+			// A repeat of the inserted user and team is skipped by DO NOTHING
+			results[i] = models.CreateResult{ID: w.offer.ID, Action: models.CreateActionSkipped}
+		case ok && w.inserted:
+			results[i] = models.CreateResult{ID: w.offer.ID, Action: models.CreateActionInserted}
+		case ok:
+			results[i] = models.CreateResult{ID: w.offer.ID, Action: models.CreateActionUpdated}
+		case existing[key] != nil:
+			results[i] = models.CreateResult{ID: existing[key].ID, Action: models.CreateActionSkipped}
+		default:
+			// Inserted by a concurrent transaction and skipped without locking
+			results[i] = models.CreateResult{Action: models.CreateActionSkipped}
+		}
+
+		if !ok || w.reported {
+			continue
+		}
+		w.reported = true
+
+		action, before := models.OfferHistoryActionCreated, (*models.Offer)(nil)
+		if !w.inserted {
+			action = models.OfferHistoryActionUpdated
+			// An offer inserted by a concurrent transaction after the lock has no known state before
+			if before = existing[key]; before == nil {
+				before = &w.offer
+			}
+		}
+
+		if err := insertHistory(ctx, tx, w.offer.ID, action, models.DiffOffers(before, &w.offer)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createEach creates the offers one by one, a failed offer is rolled back to its savepoint
+// and its error is put to "results" instead of aborting the transaction.
+func createEach(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	offers []models.Offer,
+	policy models.ConflictPolicy,
+	results []models.CreateResult,
+) error {
+	for i := range offers {
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT offer_create"); err != nil {
+			return err
+		}
+
+		if err := createBatch(ctx, tx, offers[i:i+1], policy, results[i:i+1]); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT offer_create"); rbErr != nil {
+				return fmt.Errorf("%w (rollback to savepoint failed: %v)", err, rbErr)
+			}
+
+			results[i] = models.CreateResult{Err: err}
+		}
+	}
+
+	return nil
+}
+
+// lockOffer reads the offer, deleted or not, and locks its row until the end of the transaction.
+func lockOffer(ctx context.Context, tx *sqlx.Tx, offerID uint64) (*models.Offer, error) {
+	query := sq.
+		Select(offerColumns...).
+		From("offer").
+		Where(sq.Eq{"id": offerID}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		PlaceholderFormat(sq.Dollar)
+
+	var offer models.Offer
+
+	if err := scanOffer(query.QueryRowContext(ctx), &offer); err != nil {
+		return nil, offerError(offerID, err)
+	}
+
+	return &offer, nil
+}
+
+// filterCondition builds the WHERE condition of the filter, removed offers are excluded unless requested.
+func filterCondition(filter models.OfferFilter) sq.And {
+	where := sq.And{}
+
+	if !filter.IncludeDeleted {
+		where = append(where, sq.Eq{"is_deleted": false})
+	}
+
+	if len(filter.UserIDs) > 0 {
+		where = append(where, sq.Eq{"user_id": filter.UserIDs})
+	}
+
+	if len(filter.TeamIDs) > 0 {
+		where = append(where, sq.Eq{"team_id": filter.TeamIDs})
+	}
+
+	if filter.GradeMin != 0 {
+		where = append(where, sq.GtOrEq{"grade": filter.GradeMin})
+	}
+
+	if filter.GradeMax != 0 {
+		where = append(where, sq.LtOrEq{"grade": filter.GradeMax})
+	}
+
+	return where
+}
+
+// afterCondition selects the rows following the page token in the given order.
+// The order field is compared together with id, so rows with equal values are neither skipped nor repeated.
+func afterCondition(order models.OfferOrder, token *models.PageToken) (sq.Sqlizer, error) {
+	if err := token.Check(order); err != nil {
+		return nil, err
+	}
+
+	operator := ">"
+	if order.Desc {
+		operator = "<"
+	}
+
+	if order.Field == "id" {
+		return sq.Expr("id "+operator+" ?", token.LastID), nil
+	}
+
+	value, err := order.ParseSortValue(token.LastValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return sq.Expr(fmt.Sprintf("(%s, id) %s (?, ?)", order.Field, operator), value, token.LastID), nil
+}
+
+// checkVersion returns models.ErrOfferVersionMismatch if the expected version is set and differs.
+func checkVersion(offer *models.Offer, expectedVersion uint64) error {
+	if expectedVersion != 0 && offer.Version != expectedVersion {
+		return models.ErrOfferVersionMismatch
+	}
+
+	return nil
+}
+
+type offersQuery interface {
+	QueryContext(ctx context.Context) (*sql.Rows, error)
+}
+
+// queryOffers runs a query returning offerColumns and scans all rows.
+func queryOffers(ctx context.Context, query offersQuery) ([]models.Offer, error) {
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	offers := make([]models.Offer, 0)
+	for rows.Next() {
+		var offer models.Offer
+		if err := scanOffer(rows, &offer); err != nil {
+			return nil, err
+		}
+		offers = append(offers, offer)
+	}
+
+	return offers, rows.Err()
 }
@@ -0,0 +1,37 @@
+package repo_test
+
+import (
+	"os"
+	"testing"
+
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+	"github.com/ozoncp/ocp-offer-api/internal/repo/repotest"
+)
+
+// testDSNEnv - DSN of the database for the Postgres repository tests, the tests are skipped without it.
+// The database is migrated and its offers are truncated, don't point it to a database with real data.
+const testDSNEnv = "OCP_OFFER_API_TEST_DSN"
+
+func TestRepository(t *testing.T) {
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+
+	db, err := sqlx.Open("pgx", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	require.NoError(t, goose.Up(db.DB, "../../migrations"))
+
+	repotest.Run(t, func(t *testing.T, batchSize uint) repo.IRepository {
+		db.MustExec("TRUNCATE offer, offer_history, outbox, task RESTART IDENTITY")
+
+		return repo.NewRepo(db, batchSize)
+	})
+}
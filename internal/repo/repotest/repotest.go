@@ -0,0 +1,713 @@
+// Package repotest is the behavioral contract of repo.IRepository.
+// Every implementation of the repository must pass Run.
+package repotest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+)
+
+// NewRepo returns an empty repository splitting the created offers to batches of batchSize.
+type NewRepo func(t *testing.T, batchSize uint) repo.IRepository
+
+// Run checks the repository returned by newRepo against the contract.
+// Every subtest gets a new repository, so newRepo must not share the offers between the calls.
+// The subtests are not parallel, an implementation may keep its data in one database.
+func Run(t *testing.T, newRepo NewRepo) {
+	t.Run("Create and describe", func(t *testing.T) { testCreateDescribe(t, newRepo(t, 10)) })
+	t.Run("Update", func(t *testing.T) { testUpdate(t, newRepo(t, 10)) })
+	t.Run("Remove", func(t *testing.T) { testRemove(t, newRepo(t, 10)) })
+	t.Run("Soft delete visibility", func(t *testing.T) { testSoftDelete(t, newRepo(t, 10)) })
+	t.Run("Restore", func(t *testing.T) { testRestore(t, newRepo(t, 10)) })
+	t.Run("List pagination", func(t *testing.T) { testListPagination(t, newRepo(t, 10)) })
+	t.Run("List keyset pagination", func(t *testing.T) { testListKeyset(t, newRepo(t, 10)) })
+	t.Run("Multi create batches", func(t *testing.T) { testMultiCreate(t, newRepo(t, 2)) })
+	t.Run("Multi create rollback", func(t *testing.T) { testMultiCreateRollback(t, newRepo(t, 2)) })
+	t.Run("Multi create best effort", func(t *testing.T) { testMultiCreateBestEffort(t, newRepo(t, 2)) })
+	t.Run("Conflict policy", func(t *testing.T) { testConflictPolicy(t, newRepo(t, 10)) })
+	t.Run("Conflict policy repeated keys", func(t *testing.T) { testConflictPolicyRepeated(t, newRepo(t, 1)) })
+	t.Run("Offer events", func(t *testing.T) { testOfferEvents(t, newRepo(t, 10)) })
+	t.Run("Outbox", func(t *testing.T) { testOutbox(t, newRepo(t, 10)) })
+	t.Run("Outbox failure", func(t *testing.T) { testOutboxFailure(t, newRepo(t, 10)) })
+	t.Run("Task", func(t *testing.T) { testTask(t, newRepo(t, 10)) })
+	t.Run("Task failure", func(t *testing.T) { testTaskFailure(t, newRepo(t, 10)) })
+}
+
+// createOffers creates one offer per user in the team with the grade equal to the user id.
+func createOffers(t *testing.T, r repo.IRepository, teamID uint64, userIDs ...uint64) []uint64 {
+	t.Helper()
+
+	offers := make([]models.Offer, 0, len(userIDs))
+	for _, userID := range userIDs {
+		offers = append(offers, models.Offer{UserID: userID, TeamID: teamID, Grade: userID})
+	}
+
+	results, err := r.MultiCreateOffer(context.Background(), offers, models.ConflictPolicyFail, false)
+	require.NoError(t, err)
+	require.Len(t, results, len(offers))
+
+	ids := make([]uint64, 0, len(results))
+	for _, result := range results {
+		require.Equal(t, models.CreateActionInserted, result.Action)
+		ids = append(ids, result.ID)
+	}
+
+	return ids
+}
+
+func offerIDs(offers []models.Offer) []uint64 {
+	ids := make([]uint64, 0, len(offers))
+	for _, offer := range offers {
+		ids = append(ids, offer.ID)
+	}
+
+	return ids
+}
+
+func testCreateDescribe(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+
+	result, err := r.CreateOffer(ctx, models.Offer{UserID: 1, TeamID: 2, Grade: 3}, models.ConflictPolicyFail)
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateActionInserted, result.Action)
+	assert.NotZero(t, result.ID)
+
+	offer, err := r.DescribeOffer(ctx, result.ID)
+	require.NoError(t, err)
+	assert.Equal(t, result.ID, offer.ID)
+	assert.Equal(t, uint64(1), offer.UserID)
+	assert.Equal(t, uint64(2), offer.TeamID)
+	assert.Equal(t, uint64(3), offer.Grade)
+	assert.Equal(t, models.OfferStatusDraft, offer.Status)
+	assert.Equal(t, uint64(1), offer.Version)
+	assert.False(t, offer.IsDeleted)
+	assert.False(t, offer.CreatedAt.IsZero())
+
+	_, err = r.DescribeOffer(ctx, result.ID+1)
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	// Only one live offer of the user in the team
+	_, err = r.CreateOffer(ctx, models.Offer{UserID: 1, TeamID: 2, Grade: 4}, models.ConflictPolicyFail)
+	assert.ErrorIs(t, err, repo.ErrAlreadyExists)
+
+	history, err := r.GetOfferHistory(ctx, result.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, models.OfferHistoryActionCreated, history[0].Action)
+}
+
+func testUpdate(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	ids := createOffers(t, r, 1, 1)
+
+	err := r.UpdateOffer(ctx, models.Offer{ID: ids[0], UserID: 1, TeamID: 1, Grade: 5, Version: 1})
+	require.NoError(t, err)
+
+	offer, err := r.DescribeOffer(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), offer.Grade)
+	assert.Equal(t, uint64(2), offer.Version)
+
+	// The version is checked before the change
+	err = r.UpdateOffer(ctx, models.Offer{ID: ids[0], UserID: 1, TeamID: 1, Grade: 6, Version: 1})
+	assert.ErrorIs(t, err, models.ErrOfferVersionMismatch)
+
+	err = r.PatchOffer(ctx, models.Offer{ID: ids[0], Grade: 7, Version: 2}, []string{"grade"})
+	require.NoError(t, err)
+
+	offer, err = r.DescribeOffer(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), offer.Grade)
+	assert.Equal(t, uint64(1), offer.UserID)
+	assert.Equal(t, uint64(3), offer.Version)
+
+	err = r.UpdateOffer(ctx, models.Offer{ID: ids[0] + 1, UserID: 1, TeamID: 1, Grade: 5})
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	history, err := r.GetOfferHistory(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Len(t, history, 3)
+}
+
+func testRemove(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	ids := createOffers(t, r, 1, 1, 2)
+
+	assert.ErrorIs(t, r.RemoveOffer(ctx, ids[0], 2), models.ErrOfferVersionMismatch)
+	require.NoError(t, r.RemoveOffer(ctx, ids[0], 1))
+
+	// Removing is not idempotent
+	assert.ErrorIs(t, r.RemoveOffer(ctx, ids[0], 0), repo.ErrNotFound)
+	assert.ErrorIs(t, r.RemoveOffer(ctx, ids[1]+1, 0), repo.ErrNotFound)
+
+	_, err := r.DescribeOffer(ctx, ids[0])
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	err = r.UpdateOffer(ctx, models.Offer{ID: ids[0], UserID: 1, TeamID: 1, Grade: 5})
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	err = r.PatchOffer(ctx, models.Offer{ID: ids[0], Grade: 5}, []string{"grade"})
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	err = r.UpdateOfferStatus(ctx, ids[0], models.OfferStatusDraft, models.OfferStatusSent)
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	// The other offer is kept
+	offer, err := r.DescribeOffer(ctx, ids[1])
+	require.NoError(t, err)
+	assert.False(t, offer.IsDeleted)
+}
+
+func testSoftDelete(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	ids := createOffers(t, r, 1, 1, 2, 3)
+	require.NoError(t, r.RemoveOffer(ctx, ids[1], 0))
+
+	offers, err := r.BatchGetOffers(ctx, ids)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{ids[0], ids[2]}, offerIDs(offers))
+
+	offers, info, err := r.ListOffer(ctx, models.PaginationInput{Take: 10}, models.OfferFilter{}, models.DefaultOfferOrder)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{ids[0], ids[2]}, offerIDs(offers))
+	assert.Equal(t, uint64(2), info.TotalItems)
+
+	offers, info, err = r.ListOffer(
+		ctx, models.PaginationInput{Take: 10}, models.OfferFilter{IncludeDeleted: true}, models.DefaultOfferOrder,
+	)
+	require.NoError(t, err)
+	require.Equal(t, ids, offerIDs(offers))
+	assert.True(t, offers[1].IsDeleted)
+	assert.Equal(t, uint64(3), info.TotalItems)
+
+	var exported []uint64
+	err = r.ExportOffers(ctx, models.OfferFilter{}, func(offer *models.Offer) error {
+		exported = append(exported, offer.ID)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{ids[0], ids[2]}, exported)
+
+	// The removed offer doesn't hold the user and the team
+	result, err := r.CreateOffer(ctx, models.Offer{UserID: 2, TeamID: 1, Grade: 2}, models.ConflictPolicyFail)
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateActionInserted, result.Action)
+	assert.NotEqual(t, ids[1], result.ID)
+}
+
+func testRestore(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	ids := createOffers(t, r, 1, 1, 2)
+
+	assert.ErrorIs(t, r.RestoreOffer(ctx, ids[0]), repo.ErrConflict)
+
+	require.NoError(t, r.RemoveOffer(ctx, ids[0], 0))
+	require.NoError(t, r.RestoreOffer(ctx, ids[0]))
+
+	offer, err := r.DescribeOffer(ctx, ids[0])
+	require.NoError(t, err)
+	assert.False(t, offer.IsDeleted)
+
+	// The offer can't be restored while another live offer has its user and team
+	require.NoError(t, r.RemoveOffer(ctx, ids[1], 0))
+	_, err = r.CreateOffer(ctx, models.Offer{UserID: 2, TeamID: 1, Grade: 2}, models.ConflictPolicyFail)
+	require.NoError(t, err)
+	assert.ErrorIs(t, r.RestoreOffer(ctx, ids[1]), repo.ErrAlreadyExists)
+
+	assert.ErrorIs(t, r.RestoreOffer(ctx, ids[1]+100), repo.ErrNotFound)
+}
+
+func testListPagination(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	ids := createOffers(t, r, 1, 1, 2, 3, 4, 5)
+
+	testCases := []struct {
+		name       string                 // Название теста
+		pagination models.PaginationInput // Параметры страницы
+		ids        []uint64               // Оферы на странице
+		info       models.PaginationInfo  // Информация о странице без токена
+		hasToken   bool                   // Есть токен следующей страницы
+	}{
+		{
+			name:       "First page",
+			pagination: models.PaginationInput{Take: 2},
+			ids:        ids[:2],
+			info:       models.PaginationInfo{HasNextPage: true, PerPage: 2, Page: 1, TotalPages: 3, TotalItems: 5},
+			hasToken:   true,
+		},
+		{
+			name:       "Middle page",
+			pagination: models.PaginationInput{Take: 2, Skip: 2},
+			ids:        ids[2:4],
+			info: models.PaginationInfo{
+				HasPreviousPage: true, HasNextPage: true, PerPage: 2, Page: 2, TotalPages: 3, TotalItems: 5,
+			},
+			hasToken: true,
+		},
+		{
+			name:       "Last page",
+			pagination: models.PaginationInput{Take: 2, Skip: 4},
+			ids:        ids[4:],
+			info:       models.PaginationInfo{HasPreviousPage: true, PerPage: 1, Page: 3, TotalPages: 3, TotalItems: 5},
+		},
+		{
+			name:       "Exact page",
+			pagination: models.PaginationInput{Take: 5},
+			ids:        ids,
+			info:       models.PaginationInfo{PerPage: 5, Page: 1, TotalPages: 1, TotalItems: 5},
+		},
+		{
+			name:       "Beyond the last page",
+			pagination: models.PaginationInput{Take: 2, Skip: 6},
+			ids:        []uint64{},
+			info:       models.PaginationInfo{HasPreviousPage: true, Page: 4, TotalPages: 3, TotalItems: 5},
+		},
+		{
+			name:       "Without count",
+			pagination: models.PaginationInput{Take: 2, SkipCount: true},
+			ids:        ids[:2],
+			info:       models.PaginationInfo{HasNextPage: true, PerPage: 2, Page: 1},
+			hasToken:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			offers, info, err := r.ListOffer(ctx, tc.pagination, models.OfferFilter{}, models.DefaultOfferOrder)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ids, offerIDs(offers))
+			assert.Equal(t, tc.hasToken, info.NextPageToken != nil)
+			info.NextPageToken = nil
+			assert.Equal(t, tc.info, *info)
+		})
+	}
+}
+
+func testListKeyset(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	// Two offers with the same grade are ordered by id
+	ids := createOffers(t, r, 1, 1, 2, 3)
+	extra := createOffers(t, r, 2, 2)
+	ids = append(ids, extra...)
+
+	order := models.OfferOrder{Field: "grade", Desc: true}
+	pagination := models.PaginationInput{Take: 2}
+
+	var listed []uint64
+	for page := 0; ; page++ {
+		require.Less(t, page, 3, "the listing doesn't end")
+
+		offers, info, err := r.ListOffer(ctx, pagination, models.OfferFilter{}, order)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(4), info.TotalItems)
+		assert.Equal(t, page > 0, info.HasPreviousPage)
+		listed = append(listed, offerIDs(offers)...)
+
+		if info.NextPageToken == nil {
+			break
+		}
+		pagination.PageToken = info.NextPageToken
+	}
+
+	assert.Equal(t, []uint64{ids[2], ids[3], ids[1], ids[0]}, listed)
+
+	// The token is bound to the order it was issued for
+	_, _, err := r.ListOffer(ctx, pagination, models.OfferFilter{}, models.DefaultOfferOrder)
+	assert.ErrorIs(t, err, models.ErrInvalidPageToken)
+}
+
+func testMultiCreate(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+
+	// Five offers make three batches of the repository
+	ids := createOffers(t, r, 1, 1, 2, 3, 4, 5)
+	for i := 1; i < len(ids); i++ {
+		assert.Less(t, ids[i-1], ids[i])
+	}
+
+	offers, err := r.BatchGetOffers(ctx, ids)
+	require.NoError(t, err)
+	require.Len(t, offers, len(ids))
+
+	for _, offer := range offers {
+		assert.Equal(t, offer.UserID, offer.Grade)
+	}
+
+	results, err := r.MultiCreateOffer(ctx, nil, models.ConflictPolicyFail, false)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func testMultiCreateRollback(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	createOffers(t, r, 1, 5)
+
+	// The conflicting offer is in the last batch, the first batches are rolled back too
+	offers := []models.Offer{
+		{UserID: 1, TeamID: 1, Grade: 1},
+		{UserID: 2, TeamID: 1, Grade: 2},
+		{UserID: 3, TeamID: 1, Grade: 3},
+		{UserID: 4, TeamID: 1, Grade: 4},
+		{UserID: 5, TeamID: 1, Grade: 5},
+	}
+	_, err := r.MultiCreateOffer(ctx, offers, models.ConflictPolicyFail, false)
+	assert.ErrorIs(t, err, repo.ErrAlreadyExists)
+
+	listed, info, err := r.ListOffer(ctx, models.PaginationInput{Take: 10}, models.OfferFilter{}, models.DefaultOfferOrder)
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+	assert.Equal(t, uint64(1), info.TotalItems)
+
+	// Duplicates inside the request conflict with each other
+	offers = []models.Offer{{UserID: 6, TeamID: 1, Grade: 1}, {UserID: 6, TeamID: 1, Grade: 2}}
+	_, err = r.MultiCreateOffer(ctx, offers, models.ConflictPolicyFail, false)
+	assert.ErrorIs(t, err, repo.ErrAlreadyExists)
+}
+
+func testMultiCreateBestEffort(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	existing := createOffers(t, r, 1, 2)
+
+	offers := []models.Offer{
+		{UserID: 1, TeamID: 1, Grade: 1},
+		{UserID: 2, TeamID: 1, Grade: 2},
+		{UserID: 3, TeamID: 1, Grade: 3},
+	}
+	results, err := r.MultiCreateOffer(ctx, offers, models.ConflictPolicyFail, true)
+	require.NoError(t, err)
+	require.Len(t, results, len(offers))
+	assert.Equal(t, uint64(2), models.CountCreated(results))
+
+	assert.Equal(t, models.CreateActionInserted, results[0].Action)
+	assert.NoError(t, results[0].Err)
+
+	assert.Equal(t, models.CreateActionUnspecified, results[1].Action)
+	assert.Zero(t, results[1].ID)
+	assert.ErrorIs(t, results[1].Err, repo.ErrAlreadyExists)
+
+	assert.Equal(t, models.CreateActionInserted, results[2].Action)
+
+	offer, err := r.DescribeOffer(ctx, existing[0])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), offer.Grade)
+}
+
+func testConflictPolicy(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	ids := createOffers(t, r, 1, 1, 2)
+
+	offers := []models.Offer{
+		{UserID: 1, TeamID: 1, Grade: 1},
+		{UserID: 2, TeamID: 1, Grade: 7},
+		{UserID: 3, TeamID: 1, Grade: 3},
+	}
+
+	results, err := r.MultiCreateOffer(ctx, offers, models.ConflictPolicySkip, false)
+	require.NoError(t, err)
+	require.Len(t, results, len(offers))
+	assert.Equal(t, models.CreateResult{ID: ids[0], Action: models.CreateActionSkipped}, results[0])
+	assert.Equal(t, models.CreateResult{ID: ids[1], Action: models.CreateActionSkipped}, results[1])
+	assert.Equal(t, models.CreateActionInserted, results[2].Action)
+
+	offer, err := r.DescribeOffer(ctx, ids[1])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), offer.Grade)
+
+	// An offer with the same grade is skipped
+	offers[2].UserID = 4
+	results, err = r.MultiCreateOffer(ctx, offers, models.ConflictPolicyUpdateGrade, false)
+	require.NoError(t, err)
+	require.Len(t, results, len(offers))
+	assert.Equal(t, models.CreateResult{ID: ids[0], Action: models.CreateActionSkipped}, results[0])
+	assert.Equal(t, models.CreateResult{ID: ids[1], Action: models.CreateActionUpdated}, results[1])
+	assert.Equal(t, models.CreateActionInserted, results[2].Action)
+
+	offer, err = r.DescribeOffer(ctx, ids[1])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), offer.Grade)
+	assert.Equal(t, uint64(2), offer.Version)
+}
+
+// testConflictPolicyRepeated expects the batch size 1, so the offers are created with one statement.
+func testConflictPolicyRepeated(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+
+	// The same user and team in one batch can't be updated
+	repeated := []models.Offer{
+		{UserID: 1, TeamID: 1, Grade: 1},
+		{UserID: 1, TeamID: 1, Grade: 2},
+	}
+
+	_, err := r.MultiCreateOffer(ctx, repeated, models.ConflictPolicyUpdateGrade, false)
+	assert.ErrorIs(t, err, repo.ErrConflict)
+
+	// Without a live offer the skip policy inserts the first offer and skips the repeat
+	results, err := r.MultiCreateOffer(ctx, repeated, models.ConflictPolicySkip, false)
+	require.NoError(t, err)
+	require.Len(t, results, len(repeated))
+	assert.Equal(t, models.CreateActionInserted, results[0].Action)
+	assert.Equal(t, models.CreateResult{ID: results[0].ID, Action: models.CreateActionSkipped}, results[1])
+
+	offer, err := r.DescribeOffer(ctx, results[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), offer.Grade)
+
+	require.NoError(t, r.RemoveOffer(ctx, results[0].ID, 0))
+
+	_, err = r.CreateOffer(ctx, models.Offer{UserID: 1, TeamID: 1, Grade: 1}, models.ConflictPolicyFail)
+	require.NoError(t, err, "the failed batch is rolled back")
+
+	// The skip policy keeps the live offer
+	results, err = r.MultiCreateOffer(ctx, repeated, models.ConflictPolicySkip, false)
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateActionSkipped, results[0].Action)
+	assert.Equal(t, models.CreateActionSkipped, results[1].Action)
+
+	// Best effort creates the offers one by one
+	results, err = r.MultiCreateOffer(ctx, repeated, models.ConflictPolicyUpdateGrade, true)
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateActionSkipped, results[0].Action)
+	assert.Equal(t, models.CreateActionUpdated, results[1].Action)
+}
+
+func testOfferEvents(t *testing.T, r repo.IRepository) {
+	ctx := models.ContextWithActor(context.Background(), "recruiter")
+
+	last, err := r.LastOfferEventPosition(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, last)
+
+	ids := createOffers(t, r, 1, 1)
+	removed := createOffers(t, r, 2, 2)
+	require.NoError(t, r.UpdateOfferStatus(ctx, ids[0], models.OfferStatusDraft, models.OfferStatusSent))
+	require.NoError(t, r.RemoveOffer(ctx, removed[0], 0))
+
+	count, err := r.PurgeOffers(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	last, err = r.LastOfferEventPosition(ctx)
+	require.NoError(t, err)
+
+	events, err := r.ListOfferEvents(ctx, 0, models.OfferFilter{IncludeDeleted: true}, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	assert.Equal(t, last, events[3].Position)
+
+	for i := 1; i < len(events); i++ {
+		assert.Greater(t, events[i].Position, events[i-1].Position)
+	}
+
+	// Every event has the state of the offer after its revision
+	assert.Equal(t, ids[0], events[0].Offer.ID)
+	assert.Equal(t, models.OfferStatusDraft, events[0].Offer.Status)
+	assert.Equal(t, models.OfferStatusSent, events[2].Offer.Status)
+	assert.Equal(t, uint64(2), events[2].Offer.Version)
+	assert.Equal(t, "recruiter", events[2].Actor)
+
+	// The events of the purged offer are kept
+	assert.Equal(t, removed[0], events[3].OfferID)
+	assert.Equal(t, models.OfferHistoryActionRemoved, events[3].Action)
+	assert.True(t, events[3].Offer.IsDeleted)
+
+	// The filter applies to the state after the revision
+	events, err = r.ListOfferEvents(ctx, 0, models.OfferFilter{TeamIDs: []uint64{2}}, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.OfferHistoryActionCreated, events[0].Action)
+
+	events, err = r.ListOfferEvents(ctx, events[0].Position, models.OfferFilter{IncludeDeleted: true}, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, ids[0], events[0].OfferID)
+	assert.Equal(t, models.OfferHistoryActionUpdated, events[0].Action)
+
+	events, err = r.ListOfferEvents(ctx, last, models.OfferFilter{IncludeDeleted: true}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+// relayAll publishes all pending messages of the outbox and returns them.
+func relayAll(t *testing.T, r repo.IRepository) []models.OutboxMessage {
+	t.Helper()
+
+	var relayed []models.OutboxMessage
+
+	count, err := r.RelayOutbox(context.Background(), 100, func(messages []models.OutboxMessage) (uint64, error) {
+		relayed = append(relayed, messages...)
+
+		return uint64(len(messages)), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(relayed)), count)
+
+	return relayed
+}
+
+func testOutbox(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+
+	stats, err := r.GetOutboxStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, models.OutboxStats{}, stats)
+
+	ids := createOffers(t, r, 1, 1)
+	require.NoError(t, r.UpdateOffer(ctx, models.Offer{ID: ids[0], UserID: 1, TeamID: 1, Grade: 5}))
+	require.NoError(t, r.RemoveOffer(ctx, ids[0], 0))
+
+	// A rolled back write leaves nothing in the outbox
+	_, err = r.MultiCreateOffer(ctx, []models.Offer{
+		{UserID: 2, TeamID: 1, Grade: 1},
+		{UserID: 2, TeamID: 1, Grade: 1},
+	}, models.ConflictPolicyFail, false)
+	require.Error(t, err)
+
+	stats, err = r.GetOutboxStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), stats.Pending)
+	require.NotNil(t, stats.OldestCreatedAt)
+
+	messages := relayAll(t, r)
+	require.Len(t, messages, 3)
+
+	types := []string{models.OfferEventCreated, models.OfferEventUpdated, models.OfferEventRemoved}
+	for i, message := range messages {
+		assert.Equal(t, strconv.FormatUint(ids[0], 10), message.Key)
+		assert.Equal(t, types[i], message.Type)
+
+		var event models.OfferDomainEvent
+		require.NoError(t, json.Unmarshal(message.Payload, &event))
+		assert.Equal(t, types[i], event.Type)
+		assert.Equal(t, uint32(models.OfferEventSchemaVersion), event.SchemaVersion)
+		assert.Equal(t, uint64(i+1), event.Revision)
+		assert.Equal(t, models.DefaultActor, event.Actor)
+		assert.False(t, event.OccurredAt.IsZero())
+		// The offer is in its state right after the change
+		assert.Equal(t, ids[0], event.Offer.ID)
+		assert.Equal(t, uint64(i+1), event.Offer.Version)
+		assert.Equal(t, i == 2, event.Offer.IsDeleted)
+	}
+
+	// Sent messages are not published again
+	assert.Empty(t, relayAll(t, r))
+
+	stats, err = r.GetOutboxStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, models.OutboxStats{}, stats)
+
+	count, err := r.PurgeOutbox(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	count, err = r.PurgeOutbox(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), count)
+}
+
+func testOutboxFailure(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	createOffers(t, r, 1, 1, 2, 3)
+
+	publishErr := errors.New("broker is not available")
+
+	count, err := r.RelayOutbox(ctx, 2, func(messages []models.OutboxMessage) (uint64, error) {
+		assert.Len(t, messages, 2)
+
+		return 1, publishErr
+	})
+	assert.ErrorIs(t, err, publishErr)
+	assert.Equal(t, uint64(1), count)
+
+	stats, err := r.GetOutboxStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), stats.Pending)
+
+	// The failed message is the first one to publish again
+	messages := relayAll(t, r)
+	require.Len(t, messages, 2)
+	assert.Equal(t, uint32(1), messages[0].Attempts)
+	assert.Zero(t, messages[1].Attempts)
+	assert.Less(t, messages[0].ID, messages[1].ID)
+}
+
+func testTask(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	taskID := "9b2e2a4c-5f0b-4d7e-8a51-3f6c1d2e7b90"
+
+	_, err := r.GetTask(ctx, taskID)
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+	assert.ErrorIs(t, r.StartTaskPart(ctx, taskID), repo.ErrNotFound)
+
+	task := models.Task{ID: taskID, Type: models.TaskTypeMultiCreateOffer, Status: models.TaskStatusQueued, Parts: 2}
+	require.NoError(t, r.CreateTask(ctx, &task))
+	assert.False(t, task.CreatedAt.IsZero())
+
+	stored, err := r.GetTask(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskStatusQueued, stored.Status)
+	assert.Empty(t, stored.OfferIDs)
+
+	require.NoError(t, r.StartTaskPart(ctx, taskID))
+	require.NoError(t, r.FinishTaskPart(ctx, taskID, []uint64{1, 2}, nil))
+
+	stored, err = r.GetTask(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskStatusProcessing, stored.Status)
+	assert.Equal(t, uint32(1), stored.PartsDone)
+
+	require.NoError(t, r.StartTaskPart(ctx, taskID))
+	require.NoError(t, r.FinishTaskPart(ctx, taskID, []uint64{3}, nil))
+
+	stored, err = r.GetTask(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskStatusSucceeded, stored.Status)
+	assert.Equal(t, []uint64{1, 2, 3}, stored.OfferIDs)
+	assert.Empty(t, stored.Reason)
+
+	// A redelivered part doesn't change the finished task
+	require.NoError(t, r.FinishTaskPart(ctx, taskID, []uint64{4}, errors.New("duplicate")))
+
+	stored, err = r.GetTask(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskStatusSucceeded, stored.Status)
+	assert.Equal(t, []uint64{1, 2, 3}, stored.OfferIDs)
+	assert.Equal(t, uint32(2), stored.PartsDone)
+}
+
+func testTaskFailure(t *testing.T, r repo.IRepository) {
+	ctx := context.Background()
+	taskID := "0f4d8c1e-2a3b-4c5d-9e6f-7a8b9c0d1e2f"
+
+	task := models.Task{ID: taskID, Type: models.TaskTypeMultiCreateOffer, Status: models.TaskStatusQueued, Parts: 2}
+	require.NoError(t, r.CreateTask(ctx, &task))
+
+	require.NoError(t, r.FinishTaskPart(ctx, taskID, nil, errors.New("already exists")))
+
+	// The task fails at once, the other parts are still counted
+	stored, err := r.GetTask(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskStatusFailed, stored.Status)
+	assert.Equal(t, "already exists", stored.Reason)
+
+	require.NoError(t, r.StartTaskPart(ctx, taskID))
+	require.NoError(t, r.FinishTaskPart(ctx, taskID, []uint64{5}, nil))
+
+	stored, err = r.GetTask(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskStatusFailed, stored.Status)
+	assert.Equal(t, "already exists", stored.Reason)
+	assert.Equal(t, []uint64{5}, stored.OfferIDs)
+	assert.Equal(t, uint32(2), stored.PartsDone)
+}
@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+)
+
+// statsColumns - columns of the offer table by the statistics dimensions.
+var statsColumns = map[models.OfferStatsDimension]string{
+	models.OfferStatsDimensionTeam:   "team_id",
+	models.OfferStatsDimensionGrade:  "grade",
+	models.OfferStatsDimensionStatus: "status",
+}
+
+// GetOfferStats counts the offers matching the filter grouped by the dimensions.
+// The groups are ordered by the dimensions in the given order.
+func (r *Repository) GetOfferStats(
+	ctx context.Context,
+	groupBy []models.OfferStatsDimension,
+	filter models.OfferStatsFilter,
+) ([]models.OfferStatsGroup, error) {
+	columns := make([]string, len(groupBy))
+
+	for i, dimension := range groupBy {
+		column, ok := statsColumns[dimension]
+		if !ok {
+			return nil, fmt.Errorf("unknown statistics dimension %d", dimension)
+		}
+
+		columns[i] = column
+	}
+
+	where := filterCondition(filter.OfferFilter)
+
+	if filter.CreatedFrom != nil {
+		where = append(where, sq.GtOrEq{"created_at": *filter.CreatedFrom})
+	}
+
+	if filter.CreatedTo != nil {
+		where = append(where, sq.Lt{"created_at": *filter.CreatedTo})
+	}
+
+	rows, err := sq.
+		Select(append(columns, "COUNT(*)")...).
+		From("offer").
+		Where(where).
+		GroupBy(columns...).
+		OrderBy(columns...).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar).
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := make([]models.OfferStatsGroup, 0)
+	for rows.Next() {
+		var group models.OfferStatsGroup
+
+		dest := make([]interface{}, 0, len(groupBy)+1)
+		for _, dimension := range groupBy {
+			switch dimension {
+			case models.OfferStatsDimensionTeam:
+				dest = append(dest, &group.TeamID)
+			case models.OfferStatsDimensionGrade:
+				dest = append(dest, &group.Grade)
+			case models.OfferStatsDimensionStatus:
+				dest = append(dest, &group.Status)
+			}
+		}
+
+		if err := rows.Scan(append(dest, &group.Count)...); err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, rows.Err()
+}
@@ -0,0 +1,133 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+)
+
+// taskColumns - columns of the task table in the order expected by scanTask.
+var taskColumns = []string{
+	"id", "type", "status", "reason", "offer_ids", "parts", "parts_done", "created_at", "updated_at",
+}
+
+func scanTask(row sq.RowScanner, task *models.Task) error {
+	var offerIDs pq.Int64Array
+
+	if err := row.Scan(
+		&task.ID,
+		&task.Type,
+		&task.Status,
+		&task.Reason,
+		&offerIDs,
+		&task.Parts,
+		&task.PartsDone,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	task.OfferIDs = make([]uint64, 0, len(offerIDs))
+	for _, id := range offerIDs {
+		task.OfferIDs = append(task.OfferIDs, uint64(id))
+	}
+
+	return nil
+}
+
+// CreateTask stores a new task, its status is expected to be models.TaskStatusQueued.
+func (r *Repository) CreateTask(ctx context.Context, task *models.Task) error {
+	return sq.
+		Insert("task").
+		Columns("id", "type", "status", "parts").
+		Values(task.ID, task.Type, task.Status, task.Parts).
+		Suffix("RETURNING created_at, updated_at").
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar).
+		QueryRowContext(ctx).
+		Scan(&task.CreatedAt, &task.UpdatedAt)
+}
+
+// StartTaskPart moves a queued task to models.TaskStatusProcessing.
+func (r *Repository) StartTaskPart(ctx context.Context, taskID string) error {
+	return r.changeTask(ctx, taskID, func(task *models.Task) {
+		task.StartPart()
+	})
+}
+
+// FinishTaskPart counts a processed part of the task, see models.Task.FinishPart.
+func (r *Repository) FinishTaskPart(ctx context.Context, taskID string, offerIDs []uint64, taskErr error) error {
+	return r.changeTask(ctx, taskID, func(task *models.Task) {
+		task.FinishPart(offerIDs, taskErr)
+	})
+}
+
+func (r *Repository) GetTask(ctx context.Context, taskID string) (*models.Task, error) {
+	var task models.Task
+
+	row := sq.
+		Select(taskColumns...).
+		From("task").
+		Where(sq.Eq{"id": taskID}).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar).
+		QueryRowContext(ctx)
+
+	if err := scanTask(row, &task); err != nil {
+		return nil, taskError(taskID, err)
+	}
+
+	return &task, nil
+}
+
+// changeTask applies "fn" to the locked task, the parts of a task may be processed concurrently.
+func (r *Repository) changeTask(ctx context.Context, taskID string, fn func(task *models.Task)) error {
+	return r.withTx(ctx, func(tx *sqlx.Tx) error {
+		var task models.Task
+
+		row := sq.
+			Select(taskColumns...).
+			From("task").
+			Where(sq.Eq{"id": taskID}).
+			Suffix("FOR UPDATE").
+			RunWith(tx).
+			PlaceholderFormat(sq.Dollar).
+			QueryRowContext(ctx)
+
+		if err := scanTask(row, &task); err != nil {
+			return taskError(taskID, err)
+		}
+
+		fn(&task)
+
+		_, err := sq.
+			Update("task").
+			Set("status", task.Status).
+			Set("reason", task.Reason).
+			Set("offer_ids", pq.Array(task.OfferIDs)).
+			Set("parts_done", task.PartsDone).
+			Set("updated_at", sq.Expr("NOW()")).
+			Where(sq.Eq{"id": taskID}).
+			RunWith(tx).
+			PlaceholderFormat(sq.Dollar).
+			ExecContext(ctx)
+
+		return err
+	})
+}
+
+// taskError converts sql.ErrNoRows to the ErrNotFound error of the task.
+func taskError(taskID string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return taskNotFound(taskID, err)
+	}
+
+	return err
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
+)
+
+// exportCSVPath - CSV variant of ExportOffersV1, accepts the same query parameters.
+const exportCSVPath = "/v1/offers:export.csv"
+
+var exportCSVHeader = []string{
+	"id", "user_id", "team_id", "grade", "status", "expires_at", "version", "created_at", "updated_at",
+}
+
+// exportCSVHandler streams the offers from ExportOffersV1 as CSV rows.
+func exportCSVHandler(mux *runtime.ServeMux, client pb.OcpOfferApiServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r,
+			"/ozoncp.ocp_offer_api.v1.OcpOfferApiService/ExportOffersV1", runtime.WithHTTPPathPattern(exportCSVPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+
+			return
+		}
+
+		req := &pb.ExportOffersV1Request{}
+		if err := runtime.PopulateQueryParameters(req, r.URL.Query(), &utilities.DoubleArray{}); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+
+			return
+		}
+
+		stream, err := client.ExportOffersV1(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+
+			return
+		}
+
+		// Errors like an invalid filter come before the first offer, while the status can still be set
+		resp, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="offers.csv"`)
+
+		writer := csv.NewWriter(w)
+		_ = writer.Write(exportCSVHeader)
+
+		for err == nil {
+			if err = writer.Write(offerCSVRecord(resp.Offer)); err != nil {
+				break
+			}
+
+			resp, err = stream.Recv()
+		}
+
+		writer.Flush()
+
+		if !errors.Is(err, io.EOF) {
+			// The response is cut off, the client sees the missing trailing rows only
+			log.Error().Err(err).Msg("Export of offers to CSV interrupted")
+		}
+	}
+}
+
+func offerCSVRecord(offer *pb.Offer) []string {
+	return []string{
+		strconv.FormatUint(offer.Id, 10),
+		strconv.FormatUint(offer.UserId, 10),
+		strconv.FormatUint(offer.TeamId, 10),
+		strconv.FormatUint(offer.Grade, 10),
+		offer.Status.String(),
+		csvTimestamp(offer.ExpiresAt),
+		strconv.FormatUint(offer.Version, 10),
+		csvTimestamp(offer.CreatedAt),
+		csvTimestamp(offer.UpdatedAt),
+	}
+}
+
+func csvTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	return ts.AsTime().Format(time.RFC3339)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/textproto"
+	"strconv"
 
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -13,7 +15,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/ozoncp/ocp-offer-api/internal/api"
+	"github.com/ozoncp/ocp-offer-api/internal/interceptors"
 	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
 )
 
@@ -35,17 +40,34 @@ func createGatewayServer(grpcAddr, gatewayAddr string) *http.Server {
 				grpc_opentracing.WithTracer(opentracing.GlobalTracer()),
 			),
 		),
+		grpc.WithStreamInterceptor(
+			grpc_opentracing.StreamClientInterceptor(
+				grpc_opentracing.WithTracer(opentracing.GlobalTracer()),
+			),
+		),
 		grpc.WithInsecure(),
 	)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to dial server")
 	}
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithForwardResponseOption(setETag),
+	)
 	if err := pb.RegisterOcpOfferApiServiceHandler(context.Background(), mux, conn); err != nil {
 		log.Fatal().Err(err).Msg("Failed registration handler")
 	}
 
+	// Registered after the generated handlers to take precedence over them
+	if err := mux.HandlePath(http.MethodGet, exportCSVPath, exportCSVHandler(mux, pb.NewOcpOfferApiServiceClient(conn))); err != nil {
+		log.Fatal().Err(err).Msg("Failed registration handler")
+	}
+
+	if err := mux.HandlePath(http.MethodPost, importPath, importHandler(mux, pb.NewOcpOfferApiServiceClient(conn))); err != nil {
+		log.Fatal().Err(err).Msg("Failed registration handler")
+	}
+
 	gatewayServer := &http.Server{
 		Addr:    gatewayAddr,
 		Handler: tracingWrapper(mux),
@@ -54,6 +76,34 @@ func createGatewayServer(grpcAddr, gatewayAddr string) *http.Server {
 	return gatewayServer
 }
 
+// forwardedHeaders - HTTP headers passed to gRPC metadata as is, by the metadata key.
+var forwardedHeaders = map[string]string{
+	textproto.CanonicalMIMEHeaderKey(interceptors.ActorMetadataKey): interceptors.ActorMetadataKey,
+	textproto.CanonicalMIMEHeaderKey(api.IfMatchMetadataKey):        api.IfMatchMetadataKey,
+}
+
+// incomingHeaderMatcher forwards the `X-Actor` and `If-Match` headers to gRPC metadata in addition to the default headers.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if mdKey, ok := forwardedHeaders[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+		return mdKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+type offerResponse interface {
+	GetOffer() *pb.Offer
+}
+
+// setETag sets the `ETag` header to the version of the offer for responses with a single offer.
+func setETag(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if r, ok := resp.(offerResponse); ok && r.GetOffer() != nil {
+		w.Header().Set("ETag", strconv.Quote(strconv.FormatUint(r.GetOffer().Version, 10)))
+	}
+
+	return nil
+}
+
 var grpcGatewayTag = opentracing.Tag{Key: string(ext.Component), Value: "grpc-gateway"}
 
 func tracingWrapper(h http.Handler) http.Handler {
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozoncp/ocp-offer-api/internal/importer"
+	"github.com/ozoncp/ocp-offer-api/internal/interceptors"
+	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
+)
+
+const (
+	// importPath - upload of a CSV or JSONL file with offers, returns the import report.
+	// The format is taken from the `format` query parameter or the content type,
+	// `dry_run=true` only validates the rows.
+	importPath = "/v1/offers:import"
+	// importMaxBodySize - maximum size of the uploaded file.
+	importMaxBodySize = 32 << 20
+)
+
+// importFormats - formats of the uploaded file by the content type.
+var importFormats = map[string]importer.Format{
+	"text/csv":             importer.FormatCSV,
+	"application/x-ndjson": importer.FormatJSONL,
+	"application/jsonl":    importer.FormatJSONL,
+}
+
+// importHandler creates the offers through the gRPC server like the generated handlers,
+// so the import passes the interceptors and is written to the history by the actor of the request.
+func importHandler(mux *runtime.ServeMux, client pb.OcpOfferApiServiceClient) runtime.HandlerFunc {
+	imp := importer.NewImporter(func(
+		ctx context.Context,
+		req *pb.MultiCreateOfferV1Request,
+	) (*pb.MultiCreateOfferV1Response, error) {
+		return client.MultiCreateOfferV1(ctx, req)
+	})
+
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+		ctx := r.Context()
+
+		fail := func(code codes.Code, err error) {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(code, err.Error()))
+		}
+
+		format, err := importFormat(r)
+		if err != nil {
+			fail(codes.InvalidArgument, err)
+
+			return
+		}
+
+		dryRun := false
+		if value := r.URL.Query().Get("dry_run"); value != "" {
+			if dryRun, err = strconv.ParseBool(value); err != nil {
+				fail(codes.InvalidArgument, err)
+
+				return
+			}
+		}
+
+		if actor := r.Header.Get(interceptors.ActorMetadataKey); actor != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, interceptors.ActorMetadataKey, actor)
+		}
+
+		report, err := imp.Import(ctx, http.MaxBytesReader(w, r.Body, importMaxBodySize), format, dryRun)
+		if err != nil {
+			log.Error().Err(err).Msg("Import of offers -- failed")
+			fail(codes.InvalidArgument, err)
+
+			return
+		}
+
+		log.Info().
+			Bool("dryRun", report.DryRun).
+			Int("created", report.Created).
+			Int("skipped", report.Skipped).
+			Int("failed", report.Failed).
+			Msg("Import of offers - success")
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			log.Error().Err(err).Msg("Failed to write the import report")
+		}
+	}
+}
+
+func importFormat(r *http.Request) (importer.Format, error) {
+	if name := r.URL.Query().Get("format"); name != "" {
+		return importer.ParseFormat(name)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return "", importer.ErrUnknownFormat
+	}
+
+	if format, ok := importFormats[mediaType]; ok {
+		return format, nil
+	}
+
+	return "", importer.ErrUnknownFormat
+}
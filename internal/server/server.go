@@ -28,17 +28,21 @@ import (
 	"github.com/ozoncp/ocp-offer-api/internal/interceptors"
 	"github.com/ozoncp/ocp-offer-api/internal/repo"
 	"github.com/ozoncp/ocp-offer-api/internal/service"
+	"github.com/ozoncp/ocp-offer-api/internal/watch"
+	"github.com/ozoncp/ocp-offer-api/internal/worker"
 	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
 )
 
 type GrpcServer struct {
 	db        *sqlx.DB
+	dsn       string
 	batchSize uint
 }
 
-func NewGrpcServer(db *sqlx.DB, batchSize uint) *GrpcServer {
+func NewGrpcServer(db *sqlx.DB, dsn string, batchSize uint) *GrpcServer {
 	return &GrpcServer{
 		db:        db,
+		dsn:       dsn,
 		batchSize: batchSize,
 	}
 }
@@ -53,6 +57,17 @@ func (s *GrpcServer) Start() error {
 	grpcAddr := fmt.Sprintf("%s:%v", cfg.GRPC.Host, cfg.GRPC.Port)
 	metricsAddr := fmt.Sprintf("%s:%v", cfg.Metrics.Host, cfg.Metrics.Port)
 
+	hub := watch.NewHub()
+
+	var r repo.IRepository
+	if cfg.Database.Driver == repo.MemoryDriver {
+		log.Warn().Msg("The offers are kept in memory and are lost on restart")
+		r = repo.NewMemoryRepo(s.batchSize, hub.Notify)
+	} else {
+		r = repo.NewRepo(s.db, s.batchSize)
+		go hub.ListenPostgres(ctx, s.dsn)
+	}
+
 	gatewayServer := createGatewayServer(grpcAddr, gatewayAddr)
 
 	go func() {
@@ -104,17 +119,42 @@ func (s *GrpcServer) Start() error {
 			grpc_prometheus.UnaryServerInterceptor,
 			grpcrecovery.UnaryServerInterceptor(),
 			im.Logger,
+			im.Actor,
+		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_ctxtags.StreamServerInterceptor(),
+			grpc_prometheus.StreamServerInterceptor,
+			grpcrecovery.StreamServerInterceptor(),
 		)),
 	)
 
-	p, err := service.NewProducer(ctx, cfg.Kafka.Brokers, cfg.Kafka.Topic, cfg.Kafka.Capacity)
+	p, err := service.NewProducer(ctx, cfg.Kafka.Brokers, cfg.Kafka.Topic)
 	if err != nil {
 		return fmt.Errorf("failed to create a producer: %w", err)
 	}
 
-	r := repo.NewRepo(s.db, s.batchSize)
+	expiryWorker := worker.NewExpiryWorker(r, time.Duration(cfg.Expiry.Interval)*time.Second, cfg.Expiry.BatchSize)
+	go expiryWorker.Run(ctx)
+
+	publisher, err := service.NewOutboxPublisher(cfg.Kafka.Brokers, cfg.Kafka.EventsTopic)
+	if err != nil {
+		return fmt.Errorf("failed to create an outbox publisher: %w", err)
+	}
+	defer publisher.Close()
+
+	outboxRelay := worker.NewOutboxRelay(
+		r,
+		publisher.Publish,
+		time.Duration(cfg.Outbox.Interval)*time.Second,
+		time.Duration(cfg.Outbox.MaxBackoff)*time.Second,
+		cfg.Outbox.BatchSize,
+	)
+	go outboxRelay.Run(ctx)
+
+	purgeWorker := worker.NewPurgeWorker(r, time.Duration(cfg.Purge.Interval)*time.Minute, time.Duration(cfg.Purge.Retention)*time.Hour)
+	go purgeWorker.Run(ctx)
 
-	pb.RegisterOcpOfferApiServiceServer(grpcServer, api.NewOfferAPI(r, p))
+	pb.RegisterOcpOfferApiServiceServer(grpcServer, api.NewOfferAPI(r, p, hub))
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	grpc_prometheus.Register(grpcServer)
 
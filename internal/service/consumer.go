@@ -2,36 +2,82 @@ package service
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/Shopify/sarama"
-	"github.com/mitchellh/mapstructure"
 	"github.com/ozoncp/ocp-offer-api/internal/models"
 	"github.com/ozoncp/ocp-offer-api/internal/repo"
-	utils "github.com/ozoncp/ocp-offer-api/internal/utils/models"
+	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/rs/zerolog/log"
 )
 
+// consumerActor - author of the changes made from Kafka messages.
+const consumerActor = "kafka-consumer"
+
+// updateCommandFields - fields changed by pb.UpdateOfferCommand, the expiration of the offer is kept.
+var updateCommandFields = []string{"user_id", "team_id", "grade"}
+
+var (
+	totalRetries = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ocp_offer_api_consumer_retries_total",
+		Help: "Total number of retried attempts to handle Kafka messages",
+	})
+	totalDeadLetters = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ocp_offer_api_consumer_dead_letters_total",
+		Help: "Total number of Kafka messages sent to the dead-letter topic",
+	})
+)
+
 type IConsumer interface {
 	sarama.ConsumerGroupHandler
-	MessageReceived(*sarama.ConsumerMessage)
+	// MessageReceived handles the message, the transient errors are retried by the retry policy.
+	MessageReceived(ctx context.Context, m *sarama.ConsumerMessage) error
 }
 
 // Consumer represents a Sarama consumer group consumer.
+// A message that failed with a permanent error or ran out of attempts is sent to the dead-letter topic,
+// a message is marked only after it is handled or sent.
 type Consumer struct {
 	IConsumer
-	Ready  chan bool
-	repo   repo.IRepository
-	topics []string
-	cfg    *sarama.Config
+	Ready      chan bool
+	repo       repo.IRepository
+	topics     []string
+	cfg        *sarama.Config
+	retry      RetryPolicy
+	deadLetter IDeadLetter
 }
 
-func NewConsumer(r repo.IRepository, topics []string, cfg *sarama.Config) IConsumer {
+// handleError - error of the last attempt to handle the message.
+type handleError struct {
+	err      error
+	attempts int
+}
+
+func (e *handleError) Error() string {
+	return e.err.Error()
+}
+
+func (e *handleError) Unwrap() error {
+	return e.err
+}
+
+func NewConsumer(
+	r repo.IRepository,
+	topics []string,
+	cfg *sarama.Config,
+	retry RetryPolicy,
+	deadLetter IDeadLetter,
+) IConsumer {
 	return &Consumer{
-		repo:   r,
-		topics: topics,
-		cfg:    cfg,
-		Ready:  make(chan bool),
+		repo:       r,
+		topics:     topics,
+		cfg:        cfg,
+		retry:      retry,
+		deadLetter: deadLetter,
+		Ready:      make(chan bool),
 	}
 }
 
@@ -49,78 +95,167 @@ func (c *Consumer) Cleanup(sarama.ConsumerGroupSession) error {
 }
 
 // ConsumeClaim must start a consumer loop of ConsumerGroupClaim's Messages().
+// The claim stops without marking the message if the session ends during the retries or the dead-letter
+// topic is not available, the message is read again in the next session.
 func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	// NOTE:
 	// Do not move the code below to a goroutine.
 	// The `ConsumeClaim` itself is called within a goroutine, see:
 	// https://github.com/Shopify/sarama/blob/master/consumer_group.go#L27-L29
 	for message := range claim.Messages() {
-		c.MessageReceived(message)
+		if err := c.MessageReceived(session.Context(), message); err != nil {
+			if session.Context().Err() != nil {
+				return nil
+			}
+
+			if err := c.sendDeadLetter(message, err); err != nil {
+				return err
+			}
+		}
+
 		session.MarkMessage(message, "")
 	}
 
 	return nil
 }
 
-func (c *Consumer) MessageReceived(m *sarama.ConsumerMessage) {
-	var msg Message
-
-	if err := json.Unmarshal(m.Value, &msg); err != nil {
+func (c *Consumer) MessageReceived(ctx context.Context, m *sarama.ConsumerMessage) error {
+	envelope, err := decodeEnvelope(m)
+	if err != nil {
 		log.Error().Err(err).Msg("Message unmarshal error")
 
-		return
+		return &handleError{err: fmt.Errorf("%w: %v", ErrDecode, err), attempts: 1}
 	}
 
-	ctx := context.Background()
+	log.Info().
+		Str("id", envelope.Id).
+		Uint32("schema_version", envelope.SchemaVersion).
+		Str("__type", envelope.Type.String()).
+		Msg("Message received")
 
-	if msg.Type == TypeMultiCreateOffers {
-		var mapOffers map[string]models.Offer
+	ctx = models.ContextWithActor(ctx, consumerActor)
 
-		if err := mapstructure.Decode(msg.Value, &mapOffers); err != nil {
-			log.Error().Err(err).Msg("Message unmarshal error")
+	if envelope.TaskId != "" {
+		if err := c.repo.StartTaskPart(ctx, envelope.TaskId); err != nil {
+			log.Error().Err(err).Str("task_id", envelope.TaskId).Msg("Failed to start the task")
 		}
+	}
 
-		offers := utils.ConvertOffersMapStringToSlice(mapOffers)
+	var offerIDs []uint64
 
-		log.Info().
-			Uint16("__type", uint16(msg.Type)).
-			Interface("offers", offers).
-			Msg("Message received")
+	attempts, err := c.retry.Do(ctx, func() error {
+		var err error
 
-		if _, err := c.repo.MultiCreateOffer(ctx, offers); err != nil {
-			log.Error().Err(err).Send()
+		offerIDs, err = c.handle(ctx, envelope)
+		if err != nil {
+			log.Error().Err(err).Str("id", envelope.Id).Str("task_id", envelope.TaskId).Msg("Message handling failed")
 		}
 
-		return
+		return err
+	})
+
+	// The task is not finished if the consumer stops, the message is handled again in the next session
+	if envelope.TaskId != "" && (err == nil || ctx.Err() == nil) {
+		if err := c.repo.FinishTaskPart(ctx, envelope.TaskId, offerIDs, err); err != nil {
+			log.Error().Err(err).Str("task_id", envelope.TaskId).Msg("Failed to finish the task")
+		}
 	}
 
-	var offer models.Offer
-	if err := mapstructure.Decode(msg.Value, &offer); err != nil {
-		log.Error().Err(err).Msg("Message unmarshal error")
+	if err != nil {
+		return &handleError{err: err, attempts: attempts}
 	}
 
-	log.Info().
-		Uint16("__type", uint16(msg.Type)).
-		Interface("offer", offer).
-		Msg("Message received")
+	return nil
+}
+
+// sendDeadLetter puts the failed message to the dead-letter topic.
+func (c *Consumer) sendDeadLetter(m *sarama.ConsumerMessage, err error) error {
+	attempts := 1
+
+	var hErr *handleError
+	if errors.As(err, &hErr) {
+		attempts = hErr.attempts
+	}
+
+	if err := c.deadLetter.Send(m, err, attempts); err != nil {
+		log.Error().Err(err).Int64("offset", m.Offset).Msg("Failed to send the message to the dead-letter topic")
+
+		return err
+	}
+
+	totalDeadLetters.Inc()
+
+	log.Warn().
+		Err(err).
+		Int32("partition", m.Partition).
+		Int64("offset", m.Offset).
+		Int("attempts", attempts).
+		Msg("Message sent to the dead-letter topic")
+
+	return nil
+}
+
+// handle runs the command of the envelope and returns the ids of the created or changed offers.
+func (c *Consumer) handle(ctx context.Context, envelope *pb.MessageEnvelope) ([]uint64, error) {
+	switch envelope.Type {
+	case pb.MessageType_MESSAGE_TYPE_CREATE_OFFER:
+		cmd := envelope.GetCreateOffer()
+		if cmd == nil {
+			return nil, ErrPayloadMismatch
+		}
+
+		result, err := c.repo.CreateOffer(ctx, offerFromPb(cmd.Offer), models.ConflictPolicyFail)
+		if err != nil {
+			return nil, err
+		}
 
-	switch msg.Type {
-	case TypeCreateOffer:
-		if _, err := c.repo.CreateOffer(ctx, offer); err != nil {
-			log.Error().Err(err).Send()
+		return []uint64{result.ID}, nil
+
+	case pb.MessageType_MESSAGE_TYPE_UPDATE_OFFER:
+		cmd := envelope.GetUpdateOffer()
+		if cmd == nil {
+			return nil, ErrPayloadMismatch
+		}
+
+		if err := c.repo.PatchOffer(ctx, offerFromPb(cmd.Offer), updateCommandFields); err != nil {
+			return nil, err
 		}
 
-	case TypeUpdateOffer:
-		if err := c.repo.UpdateOffer(ctx, offer); err != nil {
-			log.Error().Err(err).Send()
+		return []uint64{cmd.Offer.GetId()}, nil
+
+	case pb.MessageType_MESSAGE_TYPE_REMOVE_OFFER:
+		cmd := envelope.GetRemoveOffer()
+		if cmd == nil {
+			return nil, ErrPayloadMismatch
 		}
 
-	case TypeDeleteOffer:
-		if err := c.repo.RemoveOffer(ctx, offer.ID); err != nil {
-			log.Error().Err(err).Send()
+		if err := c.repo.RemoveOffer(ctx, cmd.Id, cmd.Version); err != nil {
+			return nil, err
+		}
+
+		return []uint64{cmd.Id}, nil
+
+	case pb.MessageType_MESSAGE_TYPE_MULTI_CREATE_OFFERS:
+		cmd := envelope.GetMultiCreateOffers()
+		if cmd == nil {
+			return nil, ErrPayloadMismatch
 		}
 
+		results, err := c.repo.MultiCreateOffer(ctx, offersFromPb(cmd.Offers), models.ConflictPolicyFail, false)
+		if err != nil {
+			return nil, err
+		}
+
+		offerIDs := make([]uint64, 0, len(results))
+		for _, result := range results {
+			offerIDs = append(offerIDs, result.ID)
+		}
+
+		return offerIDs, nil
+
 	default:
-		log.Warn().Msgf("Ignore message: %v", msg)
+		log.Warn().Msgf("Ignore message: %v", envelope)
+
+		return nil, nil
 	}
 }
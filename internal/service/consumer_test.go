@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozoncp/ocp-offer-api/internal/mocks"
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
+)
+
+func TestConsumerHandle(t *testing.T) {
+	t.Parallel()
+
+	offer := models.Offer{ID: 5, UserID: 1, TeamID: 2, Grade: 3, Version: 4}
+
+	testCases := []struct {
+		name     string                                         // Название теста
+		envelope *pb.MessageEnvelope                            // Сообщение
+		expect   func(mRepo *mocks.MockIRepositoryMockRecorder) // Ожидаемые вызовы репозитория
+		offerIDs []uint64                                       // Ожидаемые идентификаторы оферов
+		err      error                                          // Ожидаемая ошибка
+	}{
+		{
+			name: "Create",
+			envelope: &pb.MessageEnvelope{
+				Type:    pb.MessageType_MESSAGE_TYPE_CREATE_OFFER,
+				Payload: &pb.MessageEnvelope_CreateOffer{CreateOffer: &pb.CreateOfferCommand{Offer: offerToPb(&offer)}},
+			},
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				mRepo.CreateOffer(gomock.Any(), offer, models.ConflictPolicyFail).Return(models.CreateResult{ID: 6}, nil)
+			},
+			offerIDs: []uint64{6},
+		},
+		{
+			name: "Update",
+			envelope: &pb.MessageEnvelope{
+				Type:    pb.MessageType_MESSAGE_TYPE_UPDATE_OFFER,
+				Payload: &pb.MessageEnvelope_UpdateOffer{UpdateOffer: &pb.UpdateOfferCommand{Offer: offerToPb(&offer)}},
+			},
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				mRepo.PatchOffer(gomock.Any(), offer, []string{"user_id", "team_id", "grade"}).Return(nil)
+			},
+			offerIDs: []uint64{5},
+		},
+		{
+			name: "Remove",
+			envelope: &pb.MessageEnvelope{
+				Type:    pb.MessageType_MESSAGE_TYPE_REMOVE_OFFER,
+				Payload: &pb.MessageEnvelope_RemoveOffer{RemoveOffer: &pb.RemoveOfferCommand{Id: 5, Version: 4}},
+			},
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				mRepo.RemoveOffer(gomock.Any(), uint64(5), uint64(4)).Return(nil)
+			},
+			offerIDs: []uint64{5},
+		},
+		{
+			name: "Multi create",
+			envelope: &pb.MessageEnvelope{
+				Type: pb.MessageType_MESSAGE_TYPE_MULTI_CREATE_OFFERS,
+				Payload: &pb.MessageEnvelope_MultiCreateOffers{
+					MultiCreateOffers: &pb.MultiCreateOffersCommand{Offers: offersToPb([]models.Offer{offer, offer})},
+				},
+			},
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				mRepo.MultiCreateOffer(gomock.Any(), []models.Offer{offer, offer}, models.ConflictPolicyFail, false).
+					Return([]models.CreateResult{{ID: 6}, {ID: 7}}, nil)
+			},
+			offerIDs: []uint64{6, 7},
+		},
+		{
+			name: "Payload of another type",
+			envelope: &pb.MessageEnvelope{
+				Type:    pb.MessageType_MESSAGE_TYPE_REMOVE_OFFER,
+				Payload: &pb.MessageEnvelope_UpdateOffer{UpdateOffer: &pb.UpdateOfferCommand{Offer: offerToPb(&offer)}},
+			},
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {},
+			err:    ErrPayloadMismatch,
+		},
+		{
+			name:     "Unknown type",
+			envelope: &pb.MessageEnvelope{Type: pb.MessageType_MESSAGE_TYPE_UNSPECIFIED},
+			expect:   func(mRepo *mocks.MockIRepositoryMockRecorder) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mRepo := mocks.NewMockIRepository(ctrl)
+			tc.expect(mRepo.EXPECT())
+
+			c := &Consumer{repo: mRepo}
+			offerIDs, err := c.handle(context.Background(), tc.envelope)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.offerIDs, offerIDs)
+		})
+	}
+}
+
+func TestConsumerTask(t *testing.T) {
+	t.Parallel()
+
+	offer := models.Offer{UserID: 1, TeamID: 2, Grade: 3}
+	createErr := errors.New("already exists")
+
+	testCases := []struct {
+		name   string                                         // Название теста
+		taskID string                                         // Идентификатор задачи в сообщении
+		expect func(mRepo *mocks.MockIRepositoryMockRecorder) // Ожидаемые вызовы репозитория
+		err    error                                          // Ожидаемая ошибка
+	}{
+		{
+			name:   "Succeeded",
+			taskID: "task",
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				gomock.InOrder(
+					mRepo.StartTaskPart(gomock.Any(), "task").Return(nil),
+					mRepo.CreateOffer(gomock.Any(), offer, models.ConflictPolicyFail).Return(models.CreateResult{ID: 6}, nil),
+					mRepo.FinishTaskPart(gomock.Any(), "task", []uint64{6}, nil).Return(nil),
+				)
+			},
+		},
+		{
+			name:   "Failed",
+			taskID: "task",
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				gomock.InOrder(
+					mRepo.StartTaskPart(gomock.Any(), "task").Return(nil),
+					mRepo.CreateOffer(gomock.Any(), offer, models.ConflictPolicyFail).Return(models.CreateResult{}, createErr),
+					mRepo.FinishTaskPart(gomock.Any(), "task", nil, createErr).Return(nil),
+				)
+			},
+			err: createErr,
+		},
+		{
+			name: "Without task",
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				mRepo.CreateOffer(gomock.Any(), offer, models.ConflictPolicyFail).Return(models.CreateResult{ID: 6}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mRepo := mocks.NewMockIRepository(ctrl)
+			tc.expect(mRepo.EXPECT())
+
+			value, err := encodeEnvelope(&pb.MessageEnvelope{
+				TaskId:  tc.taskID,
+				Type:    pb.MessageType_MESSAGE_TYPE_CREATE_OFFER,
+				Payload: &pb.MessageEnvelope_CreateOffer{CreateOffer: &pb.CreateOfferCommand{Offer: offerToPb(&offer)}},
+			})
+			require.NoError(t, err)
+
+			c := &Consumer{repo: mRepo}
+			err = c.MessageReceived(context.Background(), &sarama.ConsumerMessage{
+				Value:   value,
+				Headers: []*sarama.RecordHeader{{Key: []byte(ContentTypeHeader), Value: []byte(ContentTypeProtobuf)}},
+			})
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestConsumerUpdateKeepsExpiration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := repo.NewMemoryRepo(10, nil)
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	result, err := r.CreateOffer(ctx, models.Offer{UserID: 1, TeamID: 2, Grade: 3, ExpiresAt: &expiresAt}, models.ConflictPolicyFail)
+	require.NoError(t, err)
+
+	// The update command of TaskUpdateOfferV1 has no expiration
+	c := &Consumer{repo: r}
+	offerIDs, err := c.handle(ctx, &pb.MessageEnvelope{
+		Type: pb.MessageType_MESSAGE_TYPE_UPDATE_OFFER,
+		Payload: &pb.MessageEnvelope_UpdateOffer{UpdateOffer: &pb.UpdateOfferCommand{
+			Offer: &pb.Offer{Id: result.ID, UserId: 1, TeamId: 2, Grade: 5},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{result.ID}, offerIDs)
+
+	offer, err := r.DescribeOffer(ctx, result.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), offer.Grade)
+	require.NotNil(t, offer.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*offer.ExpiresAt))
+}
+
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+type fakeDeadLetter struct {
+	err      error
+	offsets  []int64
+	attempts []int
+}
+
+func (d *fakeDeadLetter) Send(m *sarama.ConsumerMessage, _ error, attempts int) error {
+	if d.err != nil {
+		return d.err
+	}
+
+	d.offsets = append(d.offsets, m.Offset)
+	d.attempts = append(d.attempts, attempts)
+
+	return nil
+}
+
+func TestConsumerConsumeClaim(t *testing.T) {
+	t.Parallel()
+
+	offer := models.Offer{UserID: 1, TeamID: 2, Grade: 3}
+
+	value, err := encodeEnvelope(&pb.MessageEnvelope{
+		Type:    pb.MessageType_MESSAGE_TYPE_CREATE_OFFER,
+		Payload: &pb.MessageEnvelope_CreateOffer{CreateOffer: &pb.CreateOfferCommand{Offer: offerToPb(&offer)}},
+	})
+	require.NoError(t, err)
+
+	headers := []*sarama.RecordHeader{{Key: []byte(ContentTypeHeader), Value: []byte(ContentTypeProtobuf)}}
+	unavailable := errors.New("database is not available")
+	retry := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	testCases := []struct {
+		name       string                                         // Название теста
+		deadLetter *fakeDeadLetter                                // Топик недоставленных сообщений
+		expect     func(mRepo *mocks.MockIRepositoryMockRecorder) // Ожидаемые вызовы репозитория
+		marked     []int64                                        // Отмеченные сообщения
+		dead       []int64                                        // Сообщения в топике недоставленных
+		attempts   []int                                          // Попытки сообщений в топике недоставленных
+		err        error                                          // Ожидаемая ошибка
+	}{
+		{
+			name:       "Transient error is retried",
+			deadLetter: &fakeDeadLetter{},
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				gomock.InOrder(
+					mRepo.CreateOffer(gomock.Any(), offer, models.ConflictPolicyFail).Return(models.CreateResult{}, unavailable),
+					mRepo.CreateOffer(gomock.Any(), offer, models.ConflictPolicyFail).Return(models.CreateResult{ID: 1}, nil),
+				)
+			},
+			marked:   []int64{1, 2},
+			dead:     []int64{2},
+			attempts: []int{1},
+		},
+		{
+			name:       "Attempts are over",
+			deadLetter: &fakeDeadLetter{},
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				mRepo.CreateOffer(gomock.Any(), offer, models.ConflictPolicyFail).
+					Return(models.CreateResult{}, unavailable).
+					Times(3)
+			},
+			marked:   []int64{1, 2},
+			dead:     []int64{1, 2},
+			attempts: []int{3, 1},
+		},
+		{
+			name:       "Dead-letter topic is not available",
+			deadLetter: &fakeDeadLetter{err: unavailable},
+			expect: func(mRepo *mocks.MockIRepositoryMockRecorder) {
+				mRepo.CreateOffer(gomock.Any(), offer, models.ConflictPolicyFail).Return(models.CreateResult{ID: 1}, nil)
+			},
+			marked: []int64{1},
+			err:    unavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mRepo := mocks.NewMockIRepository(ctrl)
+			tc.expect(mRepo.EXPECT())
+
+			// The second message can't be decoded
+			claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, 2)}
+			claim.messages <- &sarama.ConsumerMessage{Offset: 1, Value: value, Headers: headers}
+			claim.messages <- &sarama.ConsumerMessage{Offset: 2, Value: []byte("{"), Headers: headers}
+			close(claim.messages)
+
+			session := &fakeSession{}
+			c := &Consumer{repo: mRepo, retry: retry, deadLetter: tc.deadLetter}
+
+			assert.ErrorIs(t, c.ConsumeClaim(session, claim), tc.err)
+			assert.Equal(t, tc.marked, session.marked)
+			assert.Equal(t, tc.dead, tc.deadLetter.offsets)
+			assert.Equal(t, tc.attempts, tc.deadLetter.attempts)
+		})
+	}
+}
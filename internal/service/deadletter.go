@@ -0,0 +1,195 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/rs/zerolog/log"
+)
+
+// Headers of the dead-letter messages, the headers of the original message are kept.
+const (
+	DeadLetterHeaderPrefix    = "dlq-"
+	DeadLetterErrorHeader     = "dlq-error"
+	DeadLetterTopicHeader     = "dlq-original-topic"
+	DeadLetterPartitionHeader = "dlq-original-partition"
+	DeadLetterOffsetHeader    = "dlq-original-offset"
+	DeadLetterAttemptsHeader  = "dlq-attempts"
+	DeadLetterFailedAtHeader  = "dlq-failed-at"
+)
+
+// IDeadLetter receives the messages the consumer failed to handle.
+type IDeadLetter interface {
+	// Send puts the message failed with "err" after "attempts" attempts to the dead-letter topic.
+	Send(m *sarama.ConsumerMessage, err error, attempts int) error
+}
+
+// DeadLetterProducer sends the failed messages to the dead-letter topic.
+type DeadLetterProducer struct {
+	producer  sarama.SyncProducer
+	topicName string
+}
+
+func NewDeadLetterProducer(brokers []string, topicName string) (*DeadLetterProducer, error) {
+	producer, err := newSyncProducer(brokers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeadLetterProducer{
+		producer:  producer,
+		topicName: topicName,
+	}, nil
+}
+
+func (p *DeadLetterProducer) Send(m *sarama.ConsumerMessage, err error, attempts int) error {
+	_, _, sendErr := p.producer.SendMessage(deadLetterMessage(p.topicName, m, err, attempts))
+
+	return sendErr
+}
+
+func (p *DeadLetterProducer) Close() error {
+	return p.producer.Close()
+}
+
+// Redriver moves the messages of the dead-letter topic back to the commands topic without the dead-letter headers.
+// It is the handler of a consumer group reading the dead-letter topic.
+type Redriver struct {
+	Ready     chan bool
+	producer  sarama.SyncProducer
+	topicName string
+	// Gets a value after every moved message
+	moved chan struct{}
+}
+
+func NewRedriver(brokers []string, topicName string) (*Redriver, error) {
+	producer, err := newSyncProducer(brokers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Redriver{
+		Ready:     make(chan bool),
+		producer:  producer,
+		topicName: topicName,
+		moved:     make(chan struct{}, 1),
+	}, nil
+}
+
+// Moved signals that messages were moved since the last receive.
+func (r *Redriver) Moved() <-chan struct{} {
+	return r.moved
+}
+
+func (r *Redriver) Setup(sarama.ConsumerGroupSession) error {
+	close(r.Ready)
+
+	return nil
+}
+
+func (r *Redriver) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// ConsumeClaim marks a message only after it is sent to the commands topic.
+func (r *Redriver) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for message := range claim.Messages() {
+		if _, _, err := r.producer.SendMessage(redriveMessage(r.topicName, message)); err != nil {
+			return err
+		}
+
+		session.MarkMessage(message, "")
+
+		log.Info().
+			Int32("partition", message.Partition).
+			Int64("offset", message.Offset).
+			Msg("Dead-letter message redriven")
+
+		select {
+		case r.moved <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (r *Redriver) Close() error {
+	return r.producer.Close()
+}
+
+// ---
+
+func newSyncProducer(brokers []string) (sarama.SyncProducer, error) {
+	config := sarama.NewConfig()
+	// Messages with the same key keep their order in one partition
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+
+	return sarama.NewSyncProducer(brokers, config)
+}
+
+// deadLetterMessage - the message with the error metadata in the headers.
+func deadLetterMessage(topicName string, m *sarama.ConsumerMessage, err error, attempts int) *sarama.ProducerMessage {
+	headers := originalHeaders(m)
+	headers = append(headers,
+		sarama.RecordHeader{Key: []byte(DeadLetterErrorHeader), Value: []byte(err.Error())},
+		sarama.RecordHeader{Key: []byte(DeadLetterTopicHeader), Value: []byte(m.Topic)},
+		sarama.RecordHeader{
+			Key:   []byte(DeadLetterPartitionHeader),
+			Value: []byte(strconv.FormatInt(int64(m.Partition), 10)),
+		},
+		sarama.RecordHeader{Key: []byte(DeadLetterOffsetHeader), Value: []byte(strconv.FormatInt(m.Offset, 10))},
+		sarama.RecordHeader{Key: []byte(DeadLetterAttemptsHeader), Value: []byte(strconv.Itoa(attempts))},
+		sarama.RecordHeader{
+			Key:   []byte(DeadLetterFailedAtHeader),
+			Value: []byte(time.Now().UTC().Format(time.RFC3339Nano)),
+		},
+	)
+
+	return &sarama.ProducerMessage{
+		Topic:     topicName,
+		Key:       byteEncoder(m.Key),
+		Value:     sarama.ByteEncoder(m.Value),
+		Headers:   headers,
+		Timestamp: time.Now(),
+	}
+}
+
+// redriveMessage - the dead-letter message with the original headers only.
+func redriveMessage(topicName string, m *sarama.ConsumerMessage) *sarama.ProducerMessage {
+	return &sarama.ProducerMessage{
+		Topic:     topicName,
+		Key:       byteEncoder(m.Key),
+		Value:     sarama.ByteEncoder(m.Value),
+		Headers:   originalHeaders(m),
+		Timestamp: time.Now(),
+	}
+}
+
+// originalHeaders - the headers of the message except the dead-letter ones.
+func originalHeaders(m *sarama.ConsumerMessage) []sarama.RecordHeader {
+	headers := make([]sarama.RecordHeader, 0, len(m.Headers))
+
+	for _, header := range m.Headers {
+		if header == nil || strings.HasPrefix(string(header.Key), DeadLetterHeaderPrefix) {
+			continue
+		}
+
+		headers = append(headers, *header)
+	}
+
+	return headers
+}
+
+// byteEncoder keeps a missing key missing.
+func byteEncoder(b []byte) sarama.Encoder {
+	if b == nil {
+		return nil
+	}
+
+	return sarama.ByteEncoder(b)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func headerValues(headers []sarama.RecordHeader) map[string]string {
+	values := make(map[string]string, len(headers))
+	for _, header := range headers {
+		values[string(header.Key)] = string(header.Value)
+	}
+
+	return values
+}
+
+func TestDeadLetterMessage(t *testing.T) {
+	t.Parallel()
+
+	m := &sarama.ConsumerMessage{
+		Topic:     "ocp-offer-api",
+		Partition: 2,
+		Offset:    42,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+		Headers:   []*sarama.RecordHeader{{Key: []byte(ContentTypeHeader), Value: []byte(ContentTypeProtobuf)}},
+	}
+
+	dead := deadLetterMessage("ocp-offer-api-dlq", m, errors.New("already exists"), 3)
+	assert.Equal(t, "ocp-offer-api-dlq", dead.Topic)
+	assert.Equal(t, sarama.ByteEncoder("key"), dead.Key)
+	assert.Equal(t, sarama.ByteEncoder("value"), dead.Value)
+
+	headers := headerValues(dead.Headers)
+	assert.Equal(t, ContentTypeProtobuf, headers[ContentTypeHeader])
+	assert.Equal(t, "already exists", headers[DeadLetterErrorHeader])
+	assert.Equal(t, "ocp-offer-api", headers[DeadLetterTopicHeader])
+	assert.Equal(t, "2", headers[DeadLetterPartitionHeader])
+	assert.Equal(t, "42", headers[DeadLetterOffsetHeader])
+	assert.Equal(t, "3", headers[DeadLetterAttemptsHeader])
+	assert.NotEmpty(t, headers[DeadLetterFailedAtHeader])
+}
+
+func TestRedriveMessage(t *testing.T) {
+	t.Parallel()
+
+	dead := deadLetterMessage("ocp-offer-api-dlq", &sarama.ConsumerMessage{
+		Topic:   "ocp-offer-api",
+		Value:   []byte("value"),
+		Headers: []*sarama.RecordHeader{{Key: []byte(ContentTypeHeader), Value: []byte(ContentTypeProtobuf)}},
+	}, errors.New("already exists"), 1)
+
+	// The dead-letter message as it is read from the topic
+	m := &sarama.ConsumerMessage{Topic: dead.Topic, Value: []byte("value")}
+	for i := range dead.Headers {
+		m.Headers = append(m.Headers, &dead.Headers[i])
+	}
+
+	redriven := redriveMessage("ocp-offer-api", m)
+	assert.Equal(t, "ocp-offer-api", redriven.Topic)
+	assert.Nil(t, redriven.Key)
+	assert.Equal(t, sarama.ByteEncoder("value"), redriven.Value)
+
+	require.Len(t, redriven.Headers, 1)
+	assert.Equal(t, map[string]string{ContentTypeHeader: ContentTypeProtobuf}, headerValues(redriven.Headers))
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	utils "github.com/ozoncp/ocp-offer-api/internal/utils/models"
+	"github.com/ozoncp/ocp-offer-api/internal/utils/uuid"
+	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
+)
+
+// EnvelopeSchemaVersion - version of pb.MessageEnvelope produced by the service,
+// the consumer rejects envelopes of newer versions.
+const EnvelopeSchemaVersion = 1
+
+// ContentTypeHeader tells the protobuf envelopes from the legacy JSON messages, which have no headers.
+const (
+	ContentTypeHeader   = "content-type"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+var (
+	// ErrUnsupportedSchema - the envelope was produced by a newer version of the service.
+	ErrUnsupportedSchema = errors.New("unsupported schema version")
+	// ErrPayloadMismatch - the payload of the envelope doesn't match its type.
+	ErrPayloadMismatch = errors.New("payload doesn't match the message type")
+)
+
+// MessageType - type of the legacy JSON message.
+//
+// Deprecated: the legacy messages are only decoded during the migration to pb.MessageEnvelope.
+type MessageType uint16
+
+const (
+	TypeCreateOffer MessageType = iota
+	TypeUpdateOffer
+	TypeDeleteOffer
+	TypeMultiCreateOffers
+)
+
+// Message - legacy JSON message with the offer converted by structs.Map or, for TypeMultiCreateOffers,
+// the offers keyed by their indexes.
+//
+// Deprecated: the legacy messages are only decoded during the migration to pb.MessageEnvelope.
+type Message struct {
+	Type  MessageType
+	Value map[string]interface{}
+}
+
+// encodeEnvelope fills the id, the schema version and the moment of the envelope and marshals it.
+func encodeEnvelope(envelope *pb.MessageEnvelope) ([]byte, error) {
+	id, err := uuid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	envelope.Id = id
+	envelope.SchemaVersion = EnvelopeSchemaVersion
+	envelope.CreatedAt = timestamppb.Now()
+
+	return proto.Marshal(envelope)
+}
+
+// decodeEnvelope decodes the protobuf envelope or converts the legacy JSON message to an envelope.
+func decodeEnvelope(m *sarama.ConsumerMessage) (*pb.MessageEnvelope, error) {
+	if !isProtobuf(m) {
+		return decodeLegacyMessage(m.Value)
+	}
+
+	var envelope pb.MessageEnvelope
+	if err := proto.Unmarshal(m.Value, &envelope); err != nil {
+		return nil, err
+	}
+
+	if envelope.SchemaVersion > EnvelopeSchemaVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedSchema, envelope.SchemaVersion)
+	}
+
+	return &envelope, nil
+}
+
+func isProtobuf(m *sarama.ConsumerMessage) bool {
+	for _, header := range m.Headers {
+		if header != nil && string(header.Key) == ContentTypeHeader {
+			return string(header.Value) == ContentTypeProtobuf
+		}
+	}
+
+	return false
+}
+
+// decodeLegacyMessage converts the legacy JSON message, the envelope has no id and schema version 0.
+func decodeLegacyMessage(value []byte) (*pb.MessageEnvelope, error) {
+	var msg Message
+	if err := json.Unmarshal(value, &msg); err != nil {
+		return nil, err
+	}
+
+	if msg.Type == TypeMultiCreateOffers {
+		var mapOffers map[string]models.Offer
+		if err := decodeValue(msg.Value, &mapOffers); err != nil {
+			return nil, err
+		}
+
+		return &pb.MessageEnvelope{
+			Type: pb.MessageType_MESSAGE_TYPE_MULTI_CREATE_OFFERS,
+			Payload: &pb.MessageEnvelope_MultiCreateOffers{MultiCreateOffers: &pb.MultiCreateOffersCommand{
+				Offers: offersToPb(utils.ConvertOffersMapStringToSlice(mapOffers)),
+			}},
+		}, nil
+	}
+
+	var offer models.Offer
+	if err := decodeValue(msg.Value, &offer); err != nil {
+		return nil, err
+	}
+
+	switch msg.Type {
+	case TypeCreateOffer:
+		return &pb.MessageEnvelope{
+			Type:    pb.MessageType_MESSAGE_TYPE_CREATE_OFFER,
+			Payload: &pb.MessageEnvelope_CreateOffer{CreateOffer: &pb.CreateOfferCommand{Offer: offerToPb(&offer)}},
+		}, nil
+
+	case TypeUpdateOffer:
+		return &pb.MessageEnvelope{
+			Type:    pb.MessageType_MESSAGE_TYPE_UPDATE_OFFER,
+			Payload: &pb.MessageEnvelope_UpdateOffer{UpdateOffer: &pb.UpdateOfferCommand{Offer: offerToPb(&offer)}},
+		}, nil
+
+	case TypeDeleteOffer:
+		return &pb.MessageEnvelope{
+			Type: pb.MessageType_MESSAGE_TYPE_REMOVE_OFFER,
+			Payload: &pb.MessageEnvelope_RemoveOffer{RemoveOffer: &pb.RemoveOfferCommand{
+				Id:      offer.ID,
+				Version: offer.Version,
+			}},
+		}, nil
+	}
+
+	return &pb.MessageEnvelope{Type: pb.MessageType_MESSAGE_TYPE_UNSPECIFIED}, nil
+}
+
+func offerToPb(offer *models.Offer) *pb.Offer {
+	result := &pb.Offer{
+		Id:      offer.ID,
+		UserId:  offer.UserID,
+		Grade:   offer.Grade,
+		TeamId:  offer.TeamID,
+		Status:  pb.OfferStatus(offer.Status),
+		Version: offer.Version,
+	}
+
+	if offer.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*offer.ExpiresAt)
+	}
+
+	return result
+}
+
+func offersToPb(offers []models.Offer) []*pb.Offer {
+	result := make([]*pb.Offer, 0, len(offers))
+	for i := range offers {
+		result = append(result, offerToPb(&offers[i]))
+	}
+
+	return result
+}
+
+// offerFromPb - the fields of the offer the commands use, a missing offer is empty.
+func offerFromPb(offer *pb.Offer) models.Offer {
+	result := models.Offer{
+		ID:      offer.GetId(),
+		UserID:  offer.GetUserId(),
+		TeamID:  offer.GetTeamId(),
+		Grade:   offer.GetGrade(),
+		Status:  models.OfferStatus(offer.GetStatus()),
+		Version: offer.GetVersion(),
+	}
+
+	if offer.GetExpiresAt() != nil {
+		expiresAt := offer.GetExpiresAt().AsTime()
+		result.ExpiresAt = &expiresAt
+	}
+
+	return result
+}
+
+func offersFromPb(offers []*pb.Offer) []models.Offer {
+	result := make([]models.Offer, 0, len(offers))
+	for _, offer := range offers {
+		result = append(result, offerFromPb(offer))
+	}
+
+	return result
+}
+
+// decodeValue decodes the message payload into "output".
+// Timestamps travel through JSON as RFC 3339 strings.
+func decodeValue(input, output interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeHookFunc(time.RFC3339Nano),
+		Result:     output,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(input)
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/fatih/structs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	utils "github.com/ozoncp/ocp-offer-api/internal/utils/models"
+	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
+)
+
+func protobufMessage(t *testing.T, envelope *pb.MessageEnvelope) *sarama.ConsumerMessage {
+	t.Helper()
+
+	value, err := proto.Marshal(envelope)
+	require.NoError(t, err)
+
+	return &sarama.ConsumerMessage{
+		Headers: []*sarama.RecordHeader{{Key: []byte(ContentTypeHeader), Value: []byte(ContentTypeProtobuf)}},
+		Value:   value,
+	}
+}
+
+func legacyMessage(t *testing.T, msgType MessageType, value map[string]interface{}) *sarama.ConsumerMessage {
+	t.Helper()
+
+	data, err := json.Marshal(Message{Type: msgType, Value: value})
+	require.NoError(t, err)
+
+	return &sarama.ConsumerMessage{Value: data}
+}
+
+func TestEncodeEnvelope(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	offer := models.Offer{UserID: 1, TeamID: 2, Grade: 3, Status: models.OfferStatusSent, ExpiresAt: &expiresAt}
+
+	data, err := encodeEnvelope(&pb.MessageEnvelope{
+		Type:    pb.MessageType_MESSAGE_TYPE_CREATE_OFFER,
+		Payload: &pb.MessageEnvelope_CreateOffer{CreateOffer: &pb.CreateOfferCommand{Offer: offerToPb(&offer)}},
+	})
+	require.NoError(t, err)
+
+	envelope, err := decodeEnvelope(&sarama.ConsumerMessage{
+		Headers: []*sarama.RecordHeader{{Key: []byte(ContentTypeHeader), Value: []byte(ContentTypeProtobuf)}},
+		Value:   data,
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, envelope.Id, 36)
+	assert.Equal(t, uint32(EnvelopeSchemaVersion), envelope.SchemaVersion)
+	assert.Equal(t, pb.MessageType_MESSAGE_TYPE_CREATE_OFFER, envelope.Type)
+	assert.WithinDuration(t, time.Now(), envelope.CreatedAt.AsTime(), time.Minute)
+	assert.Equal(t, offer, offerFromPb(envelope.GetCreateOffer().GetOffer()))
+}
+
+func TestDecodeEnvelope(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	offer := models.Offer{ID: 5, UserID: 1, TeamID: 2, Grade: 3, ExpiresAt: &expiresAt, Version: 4}
+
+	testCases := []struct {
+		name     string                  // Название теста
+		message  *sarama.ConsumerMessage // Сообщение из Kafka
+		envelope *pb.MessageEnvelope     // Результат разбора
+		isError  bool                    // Если должна вернуться ошибка
+	}{
+		{
+			name: "Protobuf",
+			message: protobufMessage(t, &pb.MessageEnvelope{
+				Id:            "id",
+				SchemaVersion: EnvelopeSchemaVersion,
+				Type:          pb.MessageType_MESSAGE_TYPE_REMOVE_OFFER,
+				CreatedAt:     timestamppb.New(expiresAt),
+				Payload:       &pb.MessageEnvelope_RemoveOffer{RemoveOffer: &pb.RemoveOfferCommand{Id: 5, Version: 4}},
+			}),
+			envelope: &pb.MessageEnvelope{
+				Id:            "id",
+				SchemaVersion: EnvelopeSchemaVersion,
+				Type:          pb.MessageType_MESSAGE_TYPE_REMOVE_OFFER,
+				CreatedAt:     timestamppb.New(expiresAt),
+				Payload:       &pb.MessageEnvelope_RemoveOffer{RemoveOffer: &pb.RemoveOfferCommand{Id: 5, Version: 4}},
+			},
+		},
+		{
+			name:    "Newer schema",
+			message: protobufMessage(t, &pb.MessageEnvelope{SchemaVersion: EnvelopeSchemaVersion + 1}),
+			isError: true,
+		},
+		{
+			name: "Broken protobuf",
+			message: &sarama.ConsumerMessage{
+				Headers: []*sarama.RecordHeader{{Key: []byte(ContentTypeHeader), Value: []byte(ContentTypeProtobuf)}},
+				Value:   []byte{0xff},
+			},
+			isError: true,
+		},
+		{
+			name:    "Legacy create",
+			message: legacyMessage(t, TypeCreateOffer, structs.Map(offer)),
+			envelope: &pb.MessageEnvelope{
+				Type:    pb.MessageType_MESSAGE_TYPE_CREATE_OFFER,
+				Payload: &pb.MessageEnvelope_CreateOffer{CreateOffer: &pb.CreateOfferCommand{Offer: offerToPb(&offer)}},
+			},
+		},
+		{
+			name:    "Legacy update",
+			message: legacyMessage(t, TypeUpdateOffer, structs.Map(offer)),
+			envelope: &pb.MessageEnvelope{
+				Type:    pb.MessageType_MESSAGE_TYPE_UPDATE_OFFER,
+				Payload: &pb.MessageEnvelope_UpdateOffer{UpdateOffer: &pb.UpdateOfferCommand{Offer: offerToPb(&offer)}},
+			},
+		},
+		{
+			name:    "Legacy delete",
+			message: legacyMessage(t, TypeDeleteOffer, structs.Map(models.Offer{ID: 5})),
+			envelope: &pb.MessageEnvelope{
+				Type:    pb.MessageType_MESSAGE_TYPE_REMOVE_OFFER,
+				Payload: &pb.MessageEnvelope_RemoveOffer{RemoveOffer: &pb.RemoveOfferCommand{Id: 5}},
+			},
+		},
+		{
+			name:    "Legacy multi create",
+			message: legacyMessage(t, TypeMultiCreateOffers, utils.ConvertOffersSliceToMapString([]models.Offer{offer})),
+			envelope: &pb.MessageEnvelope{
+				Type: pb.MessageType_MESSAGE_TYPE_MULTI_CREATE_OFFERS,
+				Payload: &pb.MessageEnvelope_MultiCreateOffers{
+					MultiCreateOffers: &pb.MultiCreateOffersCommand{Offers: []*pb.Offer{offerToPb(&offer)}},
+				},
+			},
+		},
+		{
+			name:     "Legacy unknown type",
+			message:  legacyMessage(t, MessageType(100), structs.Map(offer)),
+			envelope: &pb.MessageEnvelope{Type: pb.MessageType_MESSAGE_TYPE_UNSPECIFIED},
+		},
+		{
+			name:    "Broken JSON",
+			message: &sarama.ConsumerMessage{Value: []byte("{")},
+			isError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			envelope, err := decodeEnvelope(tc.message)
+			if tc.isError {
+				assert.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tc.envelope, envelope), "got %v", envelope)
+		})
+	}
+}
@@ -2,45 +2,33 @@ package service
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/Shopify/sarama"
-	"github.com/fatih/structs"
 	"github.com/opentracing/opentracing-go"
 	"github.com/ozoncp/ocp-offer-api/internal/models"
-	utils "github.com/ozoncp/ocp-offer-api/internal/utils/models"
+	pb "github.com/ozoncp/ocp-offer-api/pkg/ocp-offer-api"
 	"github.com/rs/zerolog/log"
 )
 
+// IProducer publishes the commands of the tasks, the task id travels in the envelope.
 type IProducer interface {
-	MultiCreateOffers(offers []models.Offer, batchSize uint64)
-	CreateOffer(offer models.Offer) error
-	UpdateOffer(offer models.Offer) error
-	DeleteOffer(offerID uint64) error
+	// MultiCreateOffers publishes a command per batch.
+	MultiCreateOffers(taskID string, batches [][]models.Offer) error
+	CreateOffer(taskID string, offer models.Offer) error
+	UpdateOffer(taskID string, offer models.Offer) error
+	DeleteOffer(taskID string, offerID uint64) error
 }
 
+// Producer sends every command synchronously, so the failure to publish fails the task at once
+// instead of leaving it queued.
 type Producer struct {
-	producer    sarama.SyncProducer
-	topicName   string
-	messageChan chan *sarama.ProducerMessage
+	producer  sarama.SyncProducer
+	topicName string
 }
 
-type MessageType uint16
-
-const (
-	TypeCreateOffer MessageType = iota
-	TypeUpdateOffer
-	TypeDeleteOffer
-	TypeMultiCreateOffers
-)
-
-type Message struct {
-	Type  MessageType
-	Value map[string]interface{}
-}
-
-func NewProducer(ctx context.Context, brokers []string, topicName string, capacity uint64) (IProducer, error) {
+func NewProducer(ctx context.Context, brokers []string, topicName string) (IProducer, error) {
 	config := sarama.NewConfig()
 	config.Producer.Partitioner = sarama.NewRandomPartitioner
 	config.Producer.RequiredAcks = sarama.WaitForAll
@@ -51,94 +39,89 @@ func NewProducer(ctx context.Context, brokers []string, topicName string, capaci
 	}
 
 	p := &Producer{
-		producer:    producer,
-		topicName:   topicName,
-		messageChan: make(chan *sarama.ProducerMessage, capacity),
+		producer:  producer,
+		topicName: topicName,
 	}
 
-	go p.listener(ctx)
+	go func() {
+		<-ctx.Done()
+		p.producer.Close()
+	}()
 
 	return p, nil
 }
 
-func (p *Producer) MultiCreateOffers(offers []models.Offer, batchSize uint64) {
-	batches, err := utils.SplitOffersToBatches(offers, uint(batchSize))
-	if err != nil {
-		log.Error().Err(err).Msg("Error splitting offers")
-	}
-
+func (p *Producer) MultiCreateOffers(taskID string, batches [][]models.Offer) error {
 	for _, batch := range batches {
-		if err := p.publish(
-			"Producer.MultiCreateOffers",
-			TypeMultiCreateOffers,
-			utils.ConvertOffersSliceToMapString(batch),
-		); err != nil {
-			log.Error().Err(err).Msg("Error publish batch")
+		if err := p.publish("Producer.MultiCreateOffers", &pb.MessageEnvelope{
+			TaskId: taskID,
+			Type:   pb.MessageType_MESSAGE_TYPE_MULTI_CREATE_OFFERS,
+			Payload: &pb.MessageEnvelope_MultiCreateOffers{
+				MultiCreateOffers: &pb.MultiCreateOffersCommand{Offers: offersToPb(batch)},
+			},
+		}); err != nil {
+			return err
 		}
 	}
+
+	return nil
 }
 
-func (p *Producer) CreateOffer(offer models.Offer) error {
-	return p.publish("Producer.CreateOffer", TypeCreateOffer, structs.Map(offer))
+func (p *Producer) CreateOffer(taskID string, offer models.Offer) error {
+	return p.publish("Producer.CreateOffer", &pb.MessageEnvelope{
+		TaskId:  taskID,
+		Type:    pb.MessageType_MESSAGE_TYPE_CREATE_OFFER,
+		Payload: &pb.MessageEnvelope_CreateOffer{CreateOffer: &pb.CreateOfferCommand{Offer: offerToPb(&offer)}},
+	})
 }
 
-func (p *Producer) UpdateOffer(offer models.Offer) error {
-	return p.publish("Producer.UpdateOffer", TypeUpdateOffer, structs.Map(offer))
+func (p *Producer) UpdateOffer(taskID string, offer models.Offer) error {
+	return p.publish("Producer.UpdateOffer", &pb.MessageEnvelope{
+		TaskId:  taskID,
+		Type:    pb.MessageType_MESSAGE_TYPE_UPDATE_OFFER,
+		Payload: &pb.MessageEnvelope_UpdateOffer{UpdateOffer: &pb.UpdateOfferCommand{Offer: offerToPb(&offer)}},
+	})
 }
 
-func (p *Producer) DeleteOffer(offerID uint64) error {
-	return p.publish("Producer.DeleteOffer", TypeDeleteOffer, structs.Map(models.Offer{ID: offerID}))
+func (p *Producer) DeleteOffer(taskID string, offerID uint64) error {
+	return p.publish("Producer.DeleteOffer", &pb.MessageEnvelope{
+		TaskId:  taskID,
+		Type:    pb.MessageType_MESSAGE_TYPE_REMOVE_OFFER,
+		Payload: &pb.MessageEnvelope_RemoveOffer{RemoveOffer: &pb.RemoveOfferCommand{Id: offerID}},
+	})
 }
 
 // ---
 
-func (p *Producer) listener(ctx context.Context) {
-	for {
-		select {
-		case msg := <-p.messageChan:
-			partition, offset, err := p.producer.SendMessage(msg)
-
-			if err != nil {
-				log.Error().Msgf("failed to send message to kafka: %v", err)
-				log.Error().Msgf("retry ...")
-
-				p.messageChan <- msg
-			}
-
-			log.Info().
-				Int32("partition", partition).
-				Str("topic", msg.Topic).
-				Msgf("Delivered message to topic %s [%d] at offset %v", msg.Topic, partition, offset)
-
-		case <-ctx.Done():
-			close(p.messageChan)
-			p.producer.Close()
-
-			return
-		}
-	}
-}
-
-func (p *Producer) publish(spanName string, msgType MessageType, value map[string]interface{}) error {
+func (p *Producer) publish(spanName string, envelope *pb.MessageEnvelope) error {
 	span := opentracing.GlobalTracer().StartSpan(spanName)
 	defer span.Finish()
 
-	b, err := json.Marshal(
-		Message{
-			Type:  msgType,
-			Value: value,
-		})
+	b, err := encodeEnvelope(envelope)
 	if err != nil {
 		return err
 	}
 
-	p.messageChan <- &sarama.ProducerMessage{
-		Topic:     p.topicName,
-		Key:       sarama.StringEncoder(p.topicName),
-		Value:     sarama.StringEncoder(b),
+	msg := &sarama.ProducerMessage{
+		Topic: p.topicName,
+		Key:   sarama.StringEncoder(p.topicName),
+		Value: sarama.ByteEncoder(b),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(ContentTypeHeader), Value: []byte(ContentTypeProtobuf)},
+		},
 		Partition: -1,
 		Timestamp: time.Now(),
 	}
 
+	partition, offset, err := p.producer.SendMessage(msg)
+	if err != nil {
+		return fmt.Errorf("failed to send message to kafka: %w", err)
+	}
+
+	log.Info().
+		Int32("partition", partition).
+		Str("topic", msg.Topic).
+		Msgf("Delivered message to topic %s [%d] at offset %v", msg.Topic, partition, offset)
+
 	return nil
 }
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"github.com/Shopify/sarama"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+)
+
+// EventTypeHeader - header of the events with the type of the event, e.g. models.OfferEventCreated.
+const EventTypeHeader = "event-type"
+
+// OutboxPublisher sends the outbox messages to the events topic, which is not read by the consumer of commands.
+type OutboxPublisher struct {
+	producer  sarama.SyncProducer
+	topicName string
+}
+
+func NewOutboxPublisher(brokers []string, topicName string) (*OutboxPublisher, error) {
+	producer, err := newSyncProducer(brokers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxPublisher{
+		producer:  producer,
+		topicName: topicName,
+	}, nil
+}
+
+// Publish sends the messages one by one and stops at the first failed message.
+func (p *OutboxPublisher) Publish(messages []models.OutboxMessage) (uint64, error) {
+	for i := range messages {
+		if _, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+			Topic: p.topicName,
+			Key:   sarama.StringEncoder(messages[i].Key),
+			Value: sarama.ByteEncoder(messages[i].Payload),
+			Headers: []sarama.RecordHeader{
+				{Key: []byte(EventTypeHeader), Value: []byte(messages[i].Type)},
+			},
+			Timestamp: messages[i].CreatedAt,
+		}); err != nil {
+			return uint64(i), err
+		}
+	}
+
+	return uint64(len(messages)), nil
+}
+
+func (p *OutboxPublisher) Close() error {
+	return p.producer.Close()
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+)
+
+// ErrDecode - the message can't be decoded, it is never retried.
+var ErrDecode = errors.New("message can't be decoded")
+
+// permanentErrors - errors which are the same on every attempt, the message goes to the dead-letter topic at once.
+var permanentErrors = []error{
+	ErrDecode,
+	ErrUnsupportedSchema,
+	ErrPayloadMismatch,
+	repo.ErrNotFound,
+	repo.ErrAlreadyExists,
+	repo.ErrConflict,
+	models.ErrOfferVersionMismatch,
+	models.ErrInvalidStatusTransition,
+}
+
+// IsTransient - the error may go away on the next attempt, e.g. the database is not available.
+func IsTransient(err error) bool {
+	for _, permanent := range permanentErrors {
+		if errors.Is(err, permanent) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RetryPolicy - attempts to handle a message failing with transient errors.
+// The pause after the first attempt is InitialBackoff, it grows twice after every next attempt up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff is the pause after the given attempt, counting from 1.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	return delay
+}
+
+// Do calls "fn" until it succeeds, fails with a permanent error or runs out of attempts,
+// and returns the number of attempts. At least one attempt is made.
+// The context error is returned if the context is cancelled during a pause.
+func (p RetryPolicy) Do(ctx context.Context, fn func() error) (int, error) {
+	attempt := 1
+
+	for {
+		err := fn()
+		if err == nil || !IsTransient(err) || attempt >= p.MaxAttempts {
+			return attempt, err
+		}
+
+		totalRetries.Inc()
+
+		select {
+		case <-time.After(p.Backoff(attempt)):
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		}
+
+		attempt++
+	}
+}
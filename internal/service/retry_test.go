@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	testCases := []struct {
+		name    string        // Название теста
+		attempt int           // Номер попытки
+		delay   time.Duration // Ожидаемая пауза
+	}{
+		{name: "First attempt", attempt: 1, delay: 100 * time.Millisecond},
+		{name: "Second attempt", attempt: 2, delay: 200 * time.Millisecond},
+		{name: "Fourth attempt", attempt: 4, delay: 800 * time.Millisecond},
+		{name: "Limited by the maximum", attempt: 5, delay: time.Second},
+		{name: "Many attempts", attempt: 100, delay: time.Second},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.delay, policy.Backoff(tc.attempt))
+		})
+	}
+}
+
+func TestRetryPolicyDo(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	transient := errors.New("connection refused")
+	permanent := &repo.Error{Kind: repo.ErrAlreadyExists, Message: "already exists"}
+
+	testCases := []struct {
+		name     string  // Название теста
+		errs     []error // Ошибки попыток по порядку
+		attempts int     // Ожидаемое количество попыток
+		err      error   // Ожидаемая ошибка
+	}{
+		{name: "Success", errs: []error{nil}, attempts: 1},
+		{name: "Transient error", errs: []error{transient, transient, nil}, attempts: 3},
+		{name: "Attempts are over", errs: []error{transient, transient, transient}, attempts: 3, err: transient},
+		{name: "Permanent error", errs: []error{permanent}, attempts: 1, err: repo.ErrAlreadyExists},
+		{name: "Version mismatch", errs: []error{models.ErrOfferVersionMismatch}, attempts: 1, err: models.ErrOfferVersionMismatch},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			attempts, err := policy.Do(context.Background(), func() error {
+				calls++
+
+				return tc.errs[calls-1]
+			})
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.attempts, attempts)
+			assert.Equal(t, tc.attempts, calls)
+		})
+	}
+}
+
+func TestRetryPolicyDoCancelled(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts, err := policy.Do(ctx, func() error {
+		return errors.New("connection refused")
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, attempts)
+}
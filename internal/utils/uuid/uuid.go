@@ -0,0 +1,20 @@
+// Package uuid generates identifiers of messages and tasks.
+package uuid
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// New returns a random UUID version 4 in the canonical form.
+func New() (string, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]), nil
+}
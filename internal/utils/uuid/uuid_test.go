@@ -0,0 +1,25 @@
+package uuid_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozoncp/ocp-offer-api/internal/utils/uuid"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	pattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	first, err := uuid.New()
+	require.NoError(t, err)
+	assert.Regexp(t, pattern, first)
+
+	second, err := uuid.New()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
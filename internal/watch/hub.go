@@ -0,0 +1,108 @@
+package watch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+)
+
+// Channel - Postgres notification channel of the offer history, see the offer_history_notify trigger.
+const Channel = "offer_history"
+
+// pingInterval - the connection is checked after this time without notifications.
+const pingInterval = time.Minute
+
+// Notifier wakes up the subscribers when the offers change.
+type Notifier interface {
+	// Subscribe returns a channel receiving a value after changes and a function to unsubscribe.
+	// Several changes may be merged into one value, so a subscriber reads all new events after waking up.
+	Subscribe() (<-chan struct{}, func())
+}
+
+// Hub - Notifier fed by Notify.
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{subscribers: make(map[chan struct{}]struct{})}
+}
+
+func (h *Hub) Subscribe() (<-chan struct{}, func()) {
+	wake := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	h.subscribers[wake] = struct{}{}
+	h.mu.Unlock()
+
+	return wake, func() {
+		h.mu.Lock()
+		delete(h.subscribers, wake)
+		h.mu.Unlock()
+	}
+}
+
+// Notify wakes up all subscribers without blocking, a subscriber that isn't awake yet is not woken twice.
+func (h *Hub) Notify() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for wake := range h.subscribers {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// ListenPostgres feeds the hub from the notifications of Channel until the context is cancelled.
+// Notifications sent while the connection is lost are not delivered, so the subscribers are woken up after reconnects.
+func (h *Hub) ListenPostgres(ctx context.Context, dsn string) {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Error().Err(err).Msg("Offer history listener")
+		}
+	})
+	defer listener.Close()
+
+	if err := listener.Listen(Channel); err != nil {
+		log.Error().Err(err).Msg("Failed to listen to the offer history")
+
+		return
+	}
+
+	log.Info().Msgf("Listening to the %s notifications", Channel)
+
+	ping := time.NewTimer(pingInterval)
+	defer ping.Stop()
+
+	for {
+		if !ping.Stop() {
+			select {
+			case <-ping.C:
+			default:
+			}
+		}
+		ping.Reset(pingInterval)
+
+		select {
+		case <-ctx.Done():
+			return
+
+		// nil is sent after a reconnect
+		case <-listener.Notify:
+			h.Notify()
+
+		case <-ping.C:
+			go func() {
+				if err := listener.Ping(); err != nil {
+					log.Error().Err(err).Msg("Offer history listener ping failed")
+				}
+			}()
+		}
+	}
+}
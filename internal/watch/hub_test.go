@@ -0,0 +1,107 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// woken reports whether the subscriber has a pending wake up.
+func woken(wake <-chan struct{}) bool {
+	select {
+	case <-wake:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestHubNotify(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub()
+
+	first, unsubscribeFirst := hub.Subscribe()
+	defer unsubscribeFirst()
+
+	second, unsubscribeSecond := hub.Subscribe()
+	defer unsubscribeSecond()
+
+	assert.False(t, woken(first))
+
+	hub.Notify()
+
+	assert.True(t, woken(first))
+	assert.True(t, woken(second))
+	assert.False(t, woken(first), "one notification wakes up once")
+}
+
+func TestHubNotifySlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub()
+
+	// The subscriber never reads
+	slow, unsubscribe := hub.Subscribe()
+	defer unsubscribe()
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 100; i++ {
+			hub.Notify()
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "Notify is blocked by the slow subscriber")
+	}
+
+	// The notifications are merged into one wake up
+	assert.True(t, woken(slow))
+	assert.False(t, woken(slow))
+}
+
+func TestHubNotifyBetweenReadAndWait(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub()
+
+	wake, unsubscribe := hub.Subscribe()
+	defer unsubscribe()
+
+	hub.Notify()
+	require.True(t, woken(wake))
+
+	// A change comes while the subscriber reads the batch and isn't waiting
+	hub.Notify()
+
+	select {
+	case <-wake:
+	case <-time.After(time.Second):
+		require.Fail(t, "the notification before the wait is lost")
+	}
+}
+
+func TestHubUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub()
+
+	wake, unsubscribe := hub.Subscribe()
+	unsubscribe()
+
+	hub.Notify()
+
+	assert.False(t, woken(wake))
+	assert.Empty(t, hub.subscribers)
+
+	// A second call is harmless
+	unsubscribe()
+}
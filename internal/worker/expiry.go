@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+)
+
+// expiryActor - author of the changes made by the expiry worker.
+const expiryActor = "expiry-worker"
+
+var (
+	totalExpired = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ocp_offer_api_expired_total",
+		Help: "Total number of offers moved to the expired status by the expiry worker",
+	})
+)
+
+// ExpiryWorker periodically moves overdue sent offers to the expired status.
+type ExpiryWorker struct {
+	repo      repo.IRepository
+	interval  time.Duration
+	batchSize uint64
+}
+
+func NewExpiryWorker(r repo.IRepository, interval time.Duration, batchSize uint64) *ExpiryWorker {
+	return &ExpiryWorker{
+		repo:      r,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run blocks until the context is cancelled.
+func (w *ExpiryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	ctx = models.ContextWithActor(ctx, expiryActor)
+
+	log.Info().Msgf("Expiry worker is running every %v", w.interval)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.expire(ctx)
+
+		case <-ctx.Done():
+			log.Info().Msg("Expiry worker stopped")
+
+			return
+		}
+	}
+}
+
+// expire processes overdue offers batch by batch until a batch comes back incomplete.
+func (w *ExpiryWorker) expire(ctx context.Context) {
+	now := time.Now()
+
+	for ctx.Err() == nil {
+		count, err := w.repo.ExpireOffers(ctx, now, w.batchSize)
+		if err != nil {
+			log.Error().Err(err).Msg("Expiry worker -- failed")
+
+			return
+		}
+
+		totalExpired.Add(float64(count))
+
+		if count > 0 {
+			log.Info().Uint64("count", count).Msg("Expiry worker - offers expired")
+		}
+
+		if count < w.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/ozoncp/ocp-offer-api/internal/mocks"
+)
+
+func TestExpiryWorkerExpire(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string   // Название теста
+		batches []uint64 // Сколько оферов вернёт каждый вызов ExpireOffers
+		err     error    // Ошибка последнего вызова
+	}{
+		{name: "Nothing to expire", batches: []uint64{0}},
+		{name: "Incomplete batch", batches: []uint64{3}},
+		{name: "Several full batches", batches: []uint64{10, 10, 4}},
+		{name: "Stops on error", batches: []uint64{10, 0}, err: errors.New("")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mRepo := mocks.NewMockIRepository(ctrl)
+
+			var calls []*gomock.Call
+			for i, count := range tc.batches {
+				var err error
+				if i == len(tc.batches)-1 {
+					err = tc.err
+				}
+				calls = append(calls, mRepo.EXPECT().
+					ExpireOffers(gomock.Any(), gomock.Any(), uint64(10)).
+					Return(count, err))
+			}
+			gomock.InOrder(calls...)
+
+			w := NewExpiryWorker(mRepo, time.Minute, 10)
+			w.expire(context.Background())
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+)
+
+var (
+	totalRelayed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ocp_offer_api_outbox_published_total",
+		Help: "Total number of outbox messages published to Kafka",
+	})
+	totalRelayFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ocp_offer_api_outbox_failures_total",
+		Help: "Total number of failed attempts to publish the outbox messages",
+	})
+	outboxPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "ocp_offer_api_outbox_pending",
+		Help: "Number of outbox messages waiting to be published",
+	})
+	outboxAge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "ocp_offer_api_outbox_oldest_age_seconds",
+		Help: "Age of the oldest outbox message waiting to be published, 0 if there are none",
+	})
+)
+
+// OutboxRelay publishes the messages written to the outbox by the repository.
+// A failed publishing is retried with the pause growing twice after every failure up to the maximum.
+type OutboxRelay struct {
+	repo       repo.IRepository
+	publish    repo.OutboxPublisher
+	interval   time.Duration
+	maxBackoff time.Duration
+	batchSize  uint64
+}
+
+func NewOutboxRelay(
+	r repo.IRepository,
+	publish repo.OutboxPublisher,
+	interval, maxBackoff time.Duration,
+	batchSize uint64,
+) *OutboxRelay {
+	return &OutboxRelay{
+		repo:       r,
+		publish:    publish,
+		interval:   interval,
+		maxBackoff: maxBackoff,
+		batchSize:  batchSize,
+	}
+}
+
+// Run blocks until the context is cancelled.
+func (w *OutboxRelay) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	log.Info().Msgf("Outbox relay is running every %v", w.interval)
+
+	var failures int
+
+	for {
+		select {
+		case <-timer.C:
+			if w.relay(ctx) {
+				failures = 0
+			} else {
+				failures++
+			}
+
+			w.observe(ctx)
+			timer.Reset(w.backoff(failures))
+
+		case <-ctx.Done():
+			log.Info().Msg("Outbox relay stopped")
+
+			return
+		}
+	}
+}
+
+// relay publishes the pending messages batch by batch until a batch comes back incomplete,
+// false is returned if publishing failed.
+func (w *OutboxRelay) relay(ctx context.Context) bool {
+	for ctx.Err() == nil {
+		count, err := w.repo.RelayOutbox(ctx, w.batchSize, w.publish)
+		totalRelayed.Add(float64(count))
+
+		if err != nil {
+			totalRelayFailures.Inc()
+			log.Error().Err(err).Uint64("published", count).Msg("Outbox relay -- failed")
+
+			return false
+		}
+
+		if count > 0 {
+			log.Debug().Uint64("count", count).Msg("Outbox relay - messages published")
+		}
+
+		if count < w.batchSize {
+			return true
+		}
+	}
+
+	return true
+}
+
+// observe updates the outbox metrics.
+func (w *OutboxRelay) observe(ctx context.Context) {
+	stats, err := w.repo.GetOutboxStats(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Outbox relay - failed to get outbox stats")
+
+		return
+	}
+
+	outboxPending.Set(float64(stats.Pending))
+
+	if stats.OldestCreatedAt != nil {
+		outboxAge.Set(time.Since(*stats.OldestCreatedAt).Seconds())
+	} else {
+		outboxAge.Set(0)
+	}
+}
+
+// backoff is the pause before the next run after the given number of failures in a row.
+func (w *OutboxRelay) backoff(failures int) time.Duration {
+	delay := w.interval
+
+	for i := 0; i < failures && delay < w.maxBackoff; i++ {
+		delay *= 2
+	}
+
+	if failures > 0 && delay > w.maxBackoff {
+		delay = w.maxBackoff
+	}
+
+	return delay
+}
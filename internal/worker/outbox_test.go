@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozoncp/ocp-offer-api/internal/mocks"
+	"github.com/ozoncp/ocp-offer-api/internal/models"
+)
+
+func TestOutboxRelayRelay(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string   // Название теста
+		batches []uint64 // Сколько сообщений опубликует каждый вызов RelayOutbox
+		err     error    // Ошибка последнего вызова
+	}{
+		{name: "Nothing to publish", batches: []uint64{0}},
+		{name: "Incomplete batch", batches: []uint64{3}},
+		{name: "Several full batches", batches: []uint64{10, 10, 4}},
+		{name: "Stops on error", batches: []uint64{10, 2}, err: errors.New("")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mRepo := mocks.NewMockIRepository(ctrl)
+
+			var calls []*gomock.Call
+			for i, count := range tc.batches {
+				var err error
+				if i == len(tc.batches)-1 {
+					err = tc.err
+				}
+				calls = append(calls, mRepo.EXPECT().
+					RelayOutbox(gomock.Any(), uint64(10), gomock.Any()).
+					Return(count, err))
+			}
+			gomock.InOrder(calls...)
+
+			publish := func(messages []models.OutboxMessage) (uint64, error) {
+				return uint64(len(messages)), nil
+			}
+
+			w := NewOutboxRelay(mRepo, publish, time.Second, time.Minute, 10)
+			assert.Equal(t, tc.err == nil, w.relay(context.Background()))
+		})
+	}
+}
+
+func TestOutboxRelayBackoff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string        // Название теста
+		failures int           // Неудач подряд
+		delay    time.Duration // Пауза до следующего запуска
+	}{
+		{name: "No failures", failures: 0, delay: time.Second},
+		{name: "One failure", failures: 1, delay: 2 * time.Second},
+		{name: "Several failures", failures: 4, delay: 16 * time.Second},
+		{name: "Maximum", failures: 6, delay: time.Minute},
+		{name: "Many failures", failures: 1000, delay: time.Minute},
+	}
+
+	w := NewOutboxRelay(nil, nil, time.Second, time.Minute, 10)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.delay, w.backoff(tc.failures))
+		})
+	}
+}
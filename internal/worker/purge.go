@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozoncp/ocp-offer-api/internal/repo"
+)
+
+var (
+	totalPurged = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ocp_offer_api_purged_total",
+		Help: "Total number of removed offers permanently deleted by the purge worker",
+	})
+)
+
+// PurgeWorker periodically deletes offers that were removed longer than the retention period ago
+// and outbox messages that were sent longer than the retention period ago.
+type PurgeWorker struct {
+	repo      repo.IRepository
+	interval  time.Duration
+	retention time.Duration
+}
+
+func NewPurgeWorker(r repo.IRepository, interval, retention time.Duration) *PurgeWorker {
+	return &PurgeWorker{
+		repo:      r,
+		interval:  interval,
+		retention: retention,
+	}
+}
+
+// Run blocks until the context is cancelled.
+func (w *PurgeWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Purge worker is running every %v, retention %v", w.interval, w.retention)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.purge(ctx)
+
+		case <-ctx.Done():
+			log.Info().Msg("Purge worker stopped")
+
+			return
+		}
+	}
+}
+
+func (w *PurgeWorker) purge(ctx context.Context) {
+	deletedBefore := time.Now().Add(-w.retention)
+
+	count, err := w.repo.PurgeOffers(ctx, deletedBefore)
+	if err != nil {
+		log.Error().Err(err).Msg("Purge worker -- failed")
+
+		return
+	}
+
+	totalPurged.Add(float64(count))
+
+	if count > 0 {
+		log.Info().Uint64("count", count).Msg("Purge worker - offers purged")
+	}
+
+	count, err = w.repo.PurgeOutbox(ctx, deletedBefore)
+	if err != nil {
+		log.Error().Err(err).Msg("Purge worker -- outbox failed")
+
+		return
+	}
+
+	if count > 0 {
+		log.Info().Uint64("count", count).Msg("Purge worker - sent outbox messages purged")
+	}
+}
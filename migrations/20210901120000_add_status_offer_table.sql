@@ -0,0 +1,14 @@
+-- Statuses are stored as numbers and match the OfferStatus protobuf enum:
+-- 1 - draft, 2 - sent, 3 - accepted, 4 - declined, 5 - withdrawn, 6 - expired.
+-- Existing offers become drafts.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "offer" ADD COLUMN "status" SMALLINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "offer" DROP COLUMN "status";
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "offer" ADD COLUMN "expires_at" TIMESTAMPTZ NULL;
+
+-- the expiry worker looks only for sent offers (status = 2)
+CREATE INDEX "offer.expires_at_index" ON "offer"("expires_at") WHERE "status" = 2 AND "is_deleted" = FALSE;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "offer.expires_at_index";
+ALTER TABLE "offer" DROP COLUMN "expires_at";
+-- +goose StatementEnd
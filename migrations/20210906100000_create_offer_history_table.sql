@@ -0,0 +1,23 @@
+-- Every create, update and remove of an offer appends a revision in the same transaction.
+-- "changes" is a JSON array of {"field", "old_value", "new_value"} objects.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "offer_history" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "offer_id" BIGINT NOT NULL REFERENCES "offer"("id") ON DELETE CASCADE,
+  "revision" BIGINT NOT NULL,
+  "action" SMALLINT NOT NULL,
+  "actor" TEXT NOT NULL,
+  "changes" JSONB NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- using index
+CREATE UNIQUE INDEX "offer_history.offer_revision_index" ON "offer_history"("offer_id", "revision");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE "offer_history";
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- Offers removed before this migration are considered removed at the moment of the migration.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "offer" ADD COLUMN "deleted_at" TIMESTAMPTZ NULL;
+UPDATE "offer" SET "deleted_at" = NOW() WHERE "is_deleted" = TRUE;
+
+-- the purge looks only for removed offers
+CREATE INDEX "offer.deleted_at_index" ON "offer"("deleted_at") WHERE "is_deleted" = TRUE;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "offer.deleted_at_index";
+ALTER TABLE "offer" DROP COLUMN "deleted_at";
+-- +goose StatementEnd
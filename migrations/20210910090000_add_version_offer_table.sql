@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "offer" ADD COLUMN "version" BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "offer" DROP COLUMN "version";
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- filtering by user_id is covered by "offer.user_team_id_index"
+CREATE INDEX "offer.team_id_index" ON "offer"("team_id");
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "offer.team_id_index";
+-- +goose StatementEnd
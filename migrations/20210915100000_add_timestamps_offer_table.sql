@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "offer" ADD COLUMN "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE "offer" ADD COLUMN "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- the history keeps the real moments of existing offers
+UPDATE "offer" SET
+  "created_at" = "history"."created_at",
+  "updated_at" = "history"."updated_at"
+FROM (
+  SELECT "offer_id", MIN("created_at") AS "created_at", MAX("created_at") AS "updated_at"
+  FROM "offer_history"
+  GROUP BY "offer_id"
+) AS "history"
+WHERE "offer"."id" = "history"."offer_id";
+
+-- sorting of listings, the id breaks ties
+CREATE INDEX "offer.user_id_id_index" ON "offer"("user_id", "id");
+CREATE INDEX "offer.grade_id_index" ON "offer"("grade", "id");
+CREATE INDEX "offer.created_at_id_index" ON "offer"("created_at", "id");
+CREATE INDEX "offer.updated_at_id_index" ON "offer"("updated_at", "id");
+
+-- replaces "offer.team_id_index" for sorting by team
+DROP INDEX "offer.team_id_index";
+CREATE INDEX "offer.team_id_id_index" ON "offer"("team_id", "id");
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "offer.team_id_id_index";
+CREATE INDEX "offer.team_id_index" ON "offer"("team_id");
+
+DROP INDEX "offer.updated_at_id_index";
+DROP INDEX "offer.created_at_id_index";
+DROP INDEX "offer.grade_id_index";
+DROP INDEX "offer.user_id_id_index";
+
+ALTER TABLE "offer" DROP COLUMN "updated_at";
+ALTER TABLE "offer" DROP COLUMN "created_at";
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- Subscribers of WatchOffersV1 are woken up by notifications, the events themselves are read from the history.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE FUNCTION "offer_history_notify"() RETURNS TRIGGER AS $$
+BEGIN
+  PERFORM pg_notify('offer_history', NEW."id"::TEXT);
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "offer_history_notify"
+  AFTER INSERT ON "offer_history"
+  FOR EACH ROW EXECUTE PROCEDURE "offer_history_notify"();
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER "offer_history_notify" ON "offer_history";
+DROP FUNCTION "offer_history_notify"();
+-- +goose StatementEnd
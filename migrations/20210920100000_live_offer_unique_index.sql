@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP INDEX "offer.user_team_id_index";
+
+-- only live offers are unique, so an offer can be issued again after the removal
+CREATE UNIQUE INDEX "offer.live_user_team_id_index" ON "offer"("user_id", "team_id") WHERE "is_deleted" = FALSE;
+
+-- filtering by user_id including removed offers
+CREATE INDEX "offer.user_team_id_index" ON "offer"("user_id", "team_id");
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+-- fails if an offer was issued again after the removal
+DROP INDEX "offer.user_team_id_index";
+DROP INDEX "offer.live_user_team_id_index";
+CREATE UNIQUE INDEX "offer.user_team_id_index" ON "offer"("user_id", "team_id");
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- Every revision of an offer is put to the outbox in the transaction of the change,
+-- the outbox relay publishes the messages to Kafka and marks them sent.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "outbox" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "key" TEXT NOT NULL,
+  "payload" BYTEA NOT NULL,
+  "attempts" INTEGER NOT NULL DEFAULT 0,
+  "last_error" TEXT,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "sent_at" TIMESTAMPTZ
+);
+
+-- using index
+CREATE INDEX "outbox.pending_index" ON "outbox"("id") WHERE "sent_at" IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE "outbox";
+-- +goose StatementEnd
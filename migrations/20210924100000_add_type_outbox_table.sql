@@ -0,0 +1,12 @@
+-- The type of the event is published in a message header, so consumers can skip events without parsing them.
+-- Messages written before keep the empty type.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "outbox" ADD COLUMN "type" TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "outbox" DROP COLUMN "type";
+-- +goose StatementEnd
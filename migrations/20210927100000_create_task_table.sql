@@ -0,0 +1,21 @@
+-- Async tasks of the Task* methods, the state is updated by kafka-consumer.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "task" (
+  "id" UUID PRIMARY KEY,
+  "type" SMALLINT NOT NULL,
+  "status" SMALLINT NOT NULL,
+  "reason" TEXT NOT NULL DEFAULT '',
+  "offer_ids" BIGINT[] NOT NULL DEFAULT '{}',
+  "parts" INTEGER NOT NULL,
+  "parts_done" INTEGER NOT NULL DEFAULT 0,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE "task";
+-- +goose StatementEnd
@@ -0,0 +1,62 @@
+-- WatchOffersV1 reads the history in the order of "position" instead of "id". The ids are taken before the commit,
+-- so a revision committed later may get a smaller id and be skipped by a reader that has gone past it.
+-- The position is assigned at the commit under a transaction-level advisory lock, so the positions become visible
+-- in their order. "offer" is the state of the offer after the revision, the history is kept after the purge.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE SEQUENCE "offer_history_position_seq";
+
+ALTER TABLE "offer_history"
+  ADD COLUMN "position" BIGINT,
+  ADD COLUMN "offer" JSONB;
+
+-- restarted by TRUNCATE ... RESTART IDENTITY and dropped together with the column
+ALTER SEQUENCE "offer_history_position_seq" OWNED BY "offer_history"."position";
+
+-- the existing revisions keep their order, the current state of the offer is the best known one
+UPDATE "offer_history" h
+  SET "position" = h."id", "offer" = to_jsonb(o)
+  FROM "offer" o
+  WHERE o."id" = h."offer_id";
+
+SELECT setval('offer_history_position_seq', COALESCE(MAX("position"), 0) + 1, false) FROM "offer_history";
+
+ALTER TABLE "offer_history"
+  ALTER COLUMN "offer" SET NOT NULL,
+  DROP CONSTRAINT "offer_history_offer_id_fkey";
+
+-- using index
+CREATE UNIQUE INDEX "offer_history.position_index" ON "offer_history"("position");
+
+-- the lock is held till the end of the transaction, so a position is visible only after all smaller ones
+CREATE FUNCTION "offer_history_position"() RETURNS TRIGGER AS $$
+BEGIN
+  PERFORM pg_advisory_xact_lock('offer_history'::REGCLASS::OID::BIGINT);
+  UPDATE "offer_history" SET "position" = nextval('offer_history_position_seq') WHERE "id" = NEW."id";
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- deferred to the commit, so no other lock is taken while the advisory lock is held
+CREATE CONSTRAINT TRIGGER "offer_history_position"
+  AFTER INSERT ON "offer_history"
+  DEFERRABLE INITIALLY DEFERRED
+  FOR EACH ROW EXECUTE PROCEDURE "offer_history_position"();
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER "offer_history_position" ON "offer_history";
+DROP FUNCTION "offer_history_position"();
+DROP INDEX "offer_history.position_index";
+
+-- the history of the purged offers is lost
+DELETE FROM "offer_history" h WHERE NOT EXISTS (SELECT 1 FROM "offer" o WHERE o."id" = h."offer_id");
+
+ALTER TABLE "offer_history"
+  DROP COLUMN "position",
+  DROP COLUMN "offer",
+  ADD CONSTRAINT "offer_history_offer_id_fkey" FOREIGN KEY ("offer_id") REFERENCES "offer"("id") ON DELETE CASCADE;
+-- +goose StatementEnd
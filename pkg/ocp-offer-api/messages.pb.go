@@ -0,0 +1,657 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.17.3
+// source: ozoncp/ocp-offer-api/v1/messages.proto
+
+package ocp_offer_api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MessageType - command carried by MessageEnvelope
+type MessageType int32
+
+const (
+	MessageType_MESSAGE_TYPE_UNSPECIFIED         MessageType = 0
+	MessageType_MESSAGE_TYPE_CREATE_OFFER        MessageType = 1
+	MessageType_MESSAGE_TYPE_UPDATE_OFFER        MessageType = 2
+	MessageType_MESSAGE_TYPE_REMOVE_OFFER        MessageType = 3
+	MessageType_MESSAGE_TYPE_MULTI_CREATE_OFFERS MessageType = 4
+)
+
+// Enum value maps for MessageType.
+var (
+	MessageType_name = map[int32]string{
+		0: "MESSAGE_TYPE_UNSPECIFIED",
+		1: "MESSAGE_TYPE_CREATE_OFFER",
+		2: "MESSAGE_TYPE_UPDATE_OFFER",
+		3: "MESSAGE_TYPE_REMOVE_OFFER",
+		4: "MESSAGE_TYPE_MULTI_CREATE_OFFERS",
+	}
+	MessageType_value = map[string]int32{
+		"MESSAGE_TYPE_UNSPECIFIED":         0,
+		"MESSAGE_TYPE_CREATE_OFFER":        1,
+		"MESSAGE_TYPE_UPDATE_OFFER":        2,
+		"MESSAGE_TYPE_REMOVE_OFFER":        3,
+		"MESSAGE_TYPE_MULTI_CREATE_OFFERS": 4,
+	}
+)
+
+func (x MessageType) Enum() *MessageType {
+	p := new(MessageType)
+	*p = x
+	return p
+}
+
+func (x MessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ozoncp_ocp_offer_api_v1_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (MessageType) Type() protoreflect.EnumType {
+	return &file_ozoncp_ocp_offer_api_v1_messages_proto_enumTypes[0]
+}
+
+func (x MessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageType.Descriptor instead.
+func (MessageType) EnumDescriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+// MessageEnvelope - message of the commands topic read by kafka-consumer.
+// The payload matches the type. The offers of the commands carry their status,
+// but the commands don't change it: a created offer is a draft and the status
+// is changed only by the transition methods
+type MessageEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique id of the message, the same for redelivered copies
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Incremented on incompatible changes of the envelope or the payloads
+	SchemaVersion uint32      `protobuf:"varint,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	Type          MessageType `protobuf:"varint,3,opt,name=type,proto3,enum=ozoncp.ocp_offer_api.v1.MessageType" json:"type,omitempty"`
+	// The moment the message was produced
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Id of the task tracking the command, empty for untracked commands
+	TaskId string `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Types that are assignable to Payload:
+	//	*MessageEnvelope_CreateOffer
+	//	*MessageEnvelope_UpdateOffer
+	//	*MessageEnvelope_RemoveOffer
+	//	*MessageEnvelope_MultiCreateOffers
+	Payload isMessageEnvelope_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *MessageEnvelope) Reset() {
+	*x = MessageEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEnvelope) ProtoMessage() {}
+
+func (x *MessageEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEnvelope.ProtoReflect.Descriptor instead.
+func (*MessageEnvelope) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageEnvelope) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageEnvelope) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *MessageEnvelope) GetType() MessageType {
+	if x != nil {
+		return x.Type
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *MessageEnvelope) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MessageEnvelope) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (m *MessageEnvelope) GetPayload() isMessageEnvelope_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *MessageEnvelope) GetCreateOffer() *CreateOfferCommand {
+	if x, ok := x.GetPayload().(*MessageEnvelope_CreateOffer); ok {
+		return x.CreateOffer
+	}
+	return nil
+}
+
+func (x *MessageEnvelope) GetUpdateOffer() *UpdateOfferCommand {
+	if x, ok := x.GetPayload().(*MessageEnvelope_UpdateOffer); ok {
+		return x.UpdateOffer
+	}
+	return nil
+}
+
+func (x *MessageEnvelope) GetRemoveOffer() *RemoveOfferCommand {
+	if x, ok := x.GetPayload().(*MessageEnvelope_RemoveOffer); ok {
+		return x.RemoveOffer
+	}
+	return nil
+}
+
+func (x *MessageEnvelope) GetMultiCreateOffers() *MultiCreateOffersCommand {
+	if x, ok := x.GetPayload().(*MessageEnvelope_MultiCreateOffers); ok {
+		return x.MultiCreateOffers
+	}
+	return nil
+}
+
+type isMessageEnvelope_Payload interface {
+	isMessageEnvelope_Payload()
+}
+
+type MessageEnvelope_CreateOffer struct {
+	CreateOffer *CreateOfferCommand `protobuf:"bytes,10,opt,name=create_offer,json=createOffer,proto3,oneof"`
+}
+
+type MessageEnvelope_UpdateOffer struct {
+	UpdateOffer *UpdateOfferCommand `protobuf:"bytes,11,opt,name=update_offer,json=updateOffer,proto3,oneof"`
+}
+
+type MessageEnvelope_RemoveOffer struct {
+	RemoveOffer *RemoveOfferCommand `protobuf:"bytes,12,opt,name=remove_offer,json=removeOffer,proto3,oneof"`
+}
+
+type MessageEnvelope_MultiCreateOffers struct {
+	MultiCreateOffers *MultiCreateOffersCommand `protobuf:"bytes,13,opt,name=multi_create_offers,json=multiCreateOffers,proto3,oneof"`
+}
+
+func (*MessageEnvelope_CreateOffer) isMessageEnvelope_Payload() {}
+
+func (*MessageEnvelope_UpdateOffer) isMessageEnvelope_Payload() {}
+
+func (*MessageEnvelope_RemoveOffer) isMessageEnvelope_Payload() {}
+
+func (*MessageEnvelope_MultiCreateOffers) isMessageEnvelope_Payload() {}
+
+// CreateOfferCommand - only user_id, team_id, grade and expires_at of the
+// offer are used
+type CreateOfferCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offer *Offer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *CreateOfferCommand) Reset() {
+	*x = CreateOfferCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOfferCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOfferCommand) ProtoMessage() {}
+
+func (x *CreateOfferCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOfferCommand.ProtoReflect.Descriptor instead.
+func (*CreateOfferCommand) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateOfferCommand) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+// UpdateOfferCommand - only user_id, team_id and grade of the offer are
+// updated. The version of the offer is checked unless it is 0
+type UpdateOfferCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offer *Offer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *UpdateOfferCommand) Reset() {
+	*x = UpdateOfferCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOfferCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOfferCommand) ProtoMessage() {}
+
+func (x *UpdateOfferCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOfferCommand.ProtoReflect.Descriptor instead.
+func (*UpdateOfferCommand) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateOfferCommand) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+// RemoveOfferCommand - the version of the offer is checked unless it is 0
+type RemoveOfferCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RemoveOfferCommand) Reset() {
+	*x = RemoveOfferCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveOfferCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOfferCommand) ProtoMessage() {}
+
+func (x *RemoveOfferCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOfferCommand.ProtoReflect.Descriptor instead.
+func (*RemoveOfferCommand) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveOfferCommand) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveOfferCommand) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// MultiCreateOffersCommand - the offers are created in one transaction
+type MultiCreateOffersCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offers []*Offer `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+}
+
+func (x *MultiCreateOffersCommand) Reset() {
+	*x = MultiCreateOffersCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiCreateOffersCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiCreateOffersCommand) ProtoMessage() {}
+
+func (x *MultiCreateOffersCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiCreateOffersCommand.ProtoReflect.Descriptor instead.
+func (*MultiCreateOffersCommand) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MultiCreateOffersCommand) GetOffers() []*Offer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+var File_ozoncp_ocp_offer_api_v1_messages_proto protoreflect.FileDescriptor
+
+var file_ozoncp_ocp_offer_api_v1_messages_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70,
+	0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2b, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x63, 0x70, 0x2d,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xbc, 0x04, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
+	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0c, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0c,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48,
+	0x00, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x63,
+	0x0a, 0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00,
+	0x52, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x4a,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70,
+	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x34, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52,
+	0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2a, 0xae, 0x01, 0x0a, 0x0b, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f,
+	0x4f, 0x46, 0x46, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f,
+	0x46, 0x46, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4f, 0x46,
+	0x46, 0x45, 0x52, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x04, 0x42, 0x41, 0x5a, 0x3f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70,
+	0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69,
+	0x3b, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescOnce sync.Once
+	file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescData = file_ozoncp_ocp_offer_api_v1_messages_proto_rawDesc
+)
+
+func file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescGZIP() []byte {
+	file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescOnce.Do(func() {
+		file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescData)
+	})
+	return file_ozoncp_ocp_offer_api_v1_messages_proto_rawDescData
+}
+
+var file_ozoncp_ocp_offer_api_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ozoncp_ocp_offer_api_v1_messages_proto_goTypes = []interface{}{
+	(MessageType)(0),                 // 0: ozoncp.ocp_offer_api.v1.MessageType
+	(*MessageEnvelope)(nil),          // 1: ozoncp.ocp_offer_api.v1.MessageEnvelope
+	(*CreateOfferCommand)(nil),       // 2: ozoncp.ocp_offer_api.v1.CreateOfferCommand
+	(*UpdateOfferCommand)(nil),       // 3: ozoncp.ocp_offer_api.v1.UpdateOfferCommand
+	(*RemoveOfferCommand)(nil),       // 4: ozoncp.ocp_offer_api.v1.RemoveOfferCommand
+	(*MultiCreateOffersCommand)(nil), // 5: ozoncp.ocp_offer_api.v1.MultiCreateOffersCommand
+	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(*Offer)(nil),                    // 7: ozoncp.ocp_offer_api.v1.Offer
+}
+var file_ozoncp_ocp_offer_api_v1_messages_proto_depIdxs = []int32{
+	0, // 0: ozoncp.ocp_offer_api.v1.MessageEnvelope.type:type_name -> ozoncp.ocp_offer_api.v1.MessageType
+	6, // 1: ozoncp.ocp_offer_api.v1.MessageEnvelope.created_at:type_name -> google.protobuf.Timestamp
+	2, // 2: ozoncp.ocp_offer_api.v1.MessageEnvelope.create_offer:type_name -> ozoncp.ocp_offer_api.v1.CreateOfferCommand
+	3, // 3: ozoncp.ocp_offer_api.v1.MessageEnvelope.update_offer:type_name -> ozoncp.ocp_offer_api.v1.UpdateOfferCommand
+	4, // 4: ozoncp.ocp_offer_api.v1.MessageEnvelope.remove_offer:type_name -> ozoncp.ocp_offer_api.v1.RemoveOfferCommand
+	5, // 5: ozoncp.ocp_offer_api.v1.MessageEnvelope.multi_create_offers:type_name -> ozoncp.ocp_offer_api.v1.MultiCreateOffersCommand
+	7, // 6: ozoncp.ocp_offer_api.v1.CreateOfferCommand.offer:type_name -> ozoncp.ocp_offer_api.v1.Offer
+	7, // 7: ozoncp.ocp_offer_api.v1.UpdateOfferCommand.offer:type_name -> ozoncp.ocp_offer_api.v1.Offer
+	7, // 8: ozoncp.ocp_offer_api.v1.MultiCreateOffersCommand.offers:type_name -> ozoncp.ocp_offer_api.v1.Offer
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_ozoncp_ocp_offer_api_v1_messages_proto_init() }
+func file_ozoncp_ocp_offer_api_v1_messages_proto_init() {
+	if File_ozoncp_ocp_offer_api_v1_messages_proto != nil {
+		return
+	}
+	file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOfferCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOfferCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveOfferCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiCreateOffersCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*MessageEnvelope_CreateOffer)(nil),
+		(*MessageEnvelope_UpdateOffer)(nil),
+		(*MessageEnvelope_RemoveOffer)(nil),
+		(*MessageEnvelope_MultiCreateOffers)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ozoncp_ocp_offer_api_v1_messages_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ozoncp_ocp_offer_api_v1_messages_proto_goTypes,
+		DependencyIndexes: file_ozoncp_ocp_offer_api_v1_messages_proto_depIdxs,
+		EnumInfos:         file_ozoncp_ocp_offer_api_v1_messages_proto_enumTypes,
+		MessageInfos:      file_ozoncp_ocp_offer_api_v1_messages_proto_msgTypes,
+	}.Build()
+	File_ozoncp_ocp_offer_api_v1_messages_proto = out.File
+	file_ozoncp_ocp_offer_api_v1_messages_proto_rawDesc = nil
+	file_ozoncp_ocp_offer_api_v1_messages_proto_goTypes = nil
+	file_ozoncp_ocp_offer_api_v1_messages_proto_depIdxs = nil
+}
@@ -0,0 +1,476 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: ozoncp/ocp-offer-api/v1/messages.proto
+
+package ocp_offer_api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// Validate checks the field values on MessageEnvelope with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *MessageEnvelope) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for Type
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEnvelopeValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TaskId
+
+	switch m.Payload.(type) {
+
+	case *MessageEnvelope_CreateOffer:
+
+		if v, ok := interface{}(m.GetCreateOffer()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageEnvelopeValidationError{
+					field:  "CreateOffer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MessageEnvelope_UpdateOffer:
+
+		if v, ok := interface{}(m.GetUpdateOffer()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageEnvelopeValidationError{
+					field:  "UpdateOffer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MessageEnvelope_RemoveOffer:
+
+		if v, ok := interface{}(m.GetRemoveOffer()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageEnvelopeValidationError{
+					field:  "RemoveOffer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MessageEnvelope_MultiCreateOffers:
+
+		if v, ok := interface{}(m.GetMultiCreateOffers()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageEnvelopeValidationError{
+					field:  "MultiCreateOffers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MessageEnvelopeValidationError is the validation error returned by
+// MessageEnvelope.Validate if the designated constraints aren't met.
+type MessageEnvelopeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageEnvelopeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageEnvelopeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageEnvelopeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageEnvelopeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageEnvelopeValidationError) ErrorName() string { return "MessageEnvelopeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageEnvelopeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageEnvelope.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageEnvelopeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageEnvelopeValidationError{}
+
+// Validate checks the field values on CreateOfferCommand with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateOfferCommand) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetOffer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOfferCommandValidationError{
+				field:  "Offer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateOfferCommandValidationError is the validation error returned by
+// CreateOfferCommand.Validate if the designated constraints aren't met.
+type CreateOfferCommandValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOfferCommandValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOfferCommandValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOfferCommandValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOfferCommandValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOfferCommandValidationError) ErrorName() string {
+	return "CreateOfferCommandValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOfferCommandValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOfferCommand.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOfferCommandValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOfferCommandValidationError{}
+
+// Validate checks the field values on UpdateOfferCommand with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateOfferCommand) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetOffer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOfferCommandValidationError{
+				field:  "Offer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateOfferCommandValidationError is the validation error returned by
+// UpdateOfferCommand.Validate if the designated constraints aren't met.
+type UpdateOfferCommandValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOfferCommandValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOfferCommandValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOfferCommandValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOfferCommandValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOfferCommandValidationError) ErrorName() string {
+	return "UpdateOfferCommandValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOfferCommandValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOfferCommand.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOfferCommandValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOfferCommandValidationError{}
+
+// Validate checks the field values on RemoveOfferCommand with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveOfferCommand) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Version
+
+	return nil
+}
+
+// RemoveOfferCommandValidationError is the validation error returned by
+// RemoveOfferCommand.Validate if the designated constraints aren't met.
+type RemoveOfferCommandValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOfferCommandValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOfferCommandValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOfferCommandValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOfferCommandValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOfferCommandValidationError) ErrorName() string {
+	return "RemoveOfferCommandValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOfferCommandValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOfferCommand.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOfferCommandValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOfferCommandValidationError{}
+
+// Validate checks the field values on MultiCreateOffersCommand with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiCreateOffersCommand) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetOffers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiCreateOffersCommandValidationError{
+					field:  fmt.Sprintf("Offers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiCreateOffersCommandValidationError is the validation error returned by
+// MultiCreateOffersCommand.Validate if the designated constraints aren't met.
+type MultiCreateOffersCommandValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiCreateOffersCommandValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiCreateOffersCommandValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiCreateOffersCommandValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiCreateOffersCommandValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiCreateOffersCommandValidationError) ErrorName() string {
+	return "MultiCreateOffersCommandValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiCreateOffersCommandValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiCreateOffersCommand.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiCreateOffersCommandValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiCreateOffersCommandValidationError{}
@@ -11,6 +11,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -22,16 +24,428 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// OfferStatus - Stage of the offer lifecycle
+type OfferStatus int32
+
+const (
+	OfferStatus_OFFER_STATUS_UNSPECIFIED OfferStatus = 0
+	// The offer is created but not sent to the student yet
+	OfferStatus_OFFER_STATUS_DRAFT OfferStatus = 1
+	// The offer is sent and waits for the answer
+	OfferStatus_OFFER_STATUS_SENT OfferStatus = 2
+	// The student accepted the offer
+	OfferStatus_OFFER_STATUS_ACCEPTED OfferStatus = 3
+	// The student declined the offer
+	OfferStatus_OFFER_STATUS_DECLINED OfferStatus = 4
+	// The offer was withdrawn before the answer
+	OfferStatus_OFFER_STATUS_WITHDRAWN OfferStatus = 5
+	// The student did not answer before the offer expired
+	OfferStatus_OFFER_STATUS_EXPIRED OfferStatus = 6
+)
+
+// Enum value maps for OfferStatus.
+var (
+	OfferStatus_name = map[int32]string{
+		0: "OFFER_STATUS_UNSPECIFIED",
+		1: "OFFER_STATUS_DRAFT",
+		2: "OFFER_STATUS_SENT",
+		3: "OFFER_STATUS_ACCEPTED",
+		4: "OFFER_STATUS_DECLINED",
+		5: "OFFER_STATUS_WITHDRAWN",
+		6: "OFFER_STATUS_EXPIRED",
+	}
+	OfferStatus_value = map[string]int32{
+		"OFFER_STATUS_UNSPECIFIED": 0,
+		"OFFER_STATUS_DRAFT":       1,
+		"OFFER_STATUS_SENT":        2,
+		"OFFER_STATUS_ACCEPTED":    3,
+		"OFFER_STATUS_DECLINED":    4,
+		"OFFER_STATUS_WITHDRAWN":   5,
+		"OFFER_STATUS_EXPIRED":     6,
+	}
+)
+
+func (x OfferStatus) Enum() *OfferStatus {
+	p := new(OfferStatus)
+	*p = x
+	return p
+}
+
+func (x OfferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OfferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[0].Descriptor()
+}
+
+func (OfferStatus) Type() protoreflect.EnumType {
+	return &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[0]
+}
+
+func (x OfferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OfferStatus.Descriptor instead.
+func (OfferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{0}
+}
+
+// ConflictPolicy - What to do if a live offer of the same user and team exists
+type ConflictPolicy int32
+
+const (
+	// Same as CONFLICT_POLICY_FAIL
+	ConflictPolicy_CONFLICT_POLICY_UNSPECIFIED ConflictPolicy = 0
+	// The call fails with ALREADY_EXISTS
+	ConflictPolicy_CONFLICT_POLICY_FAIL ConflictPolicy = 1
+	// The existing offer is kept as is
+	ConflictPolicy_CONFLICT_POLICY_SKIP ConflictPolicy = 2
+	// The grade of the existing offer is updated
+	ConflictPolicy_CONFLICT_POLICY_UPDATE_GRADE ConflictPolicy = 3
+)
+
+// Enum value maps for ConflictPolicy.
+var (
+	ConflictPolicy_name = map[int32]string{
+		0: "CONFLICT_POLICY_UNSPECIFIED",
+		1: "CONFLICT_POLICY_FAIL",
+		2: "CONFLICT_POLICY_SKIP",
+		3: "CONFLICT_POLICY_UPDATE_GRADE",
+	}
+	ConflictPolicy_value = map[string]int32{
+		"CONFLICT_POLICY_UNSPECIFIED":  0,
+		"CONFLICT_POLICY_FAIL":         1,
+		"CONFLICT_POLICY_SKIP":         2,
+		"CONFLICT_POLICY_UPDATE_GRADE": 3,
+	}
+)
+
+func (x ConflictPolicy) Enum() *ConflictPolicy {
+	p := new(ConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[1].Descriptor()
+}
+
+func (ConflictPolicy) Type() protoreflect.EnumType {
+	return &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[1]
+}
+
+func (x ConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictPolicy.Descriptor instead.
+func (ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{1}
+}
+
+// CreateOfferResult - What happened to a created offer
+type CreateOfferResult int32
+
+const (
+	// The offer failed in the best effort mode
+	CreateOfferResult_CREATE_OFFER_RESULT_UNSPECIFIED CreateOfferResult = 0
+	CreateOfferResult_CREATE_OFFER_RESULT_INSERTED    CreateOfferResult = 1
+	// The grade of the existing offer is updated
+	CreateOfferResult_CREATE_OFFER_RESULT_UPDATED CreateOfferResult = 2
+	// The existing offer is kept, as the policy is skip or its grade is the same
+	CreateOfferResult_CREATE_OFFER_RESULT_SKIPPED CreateOfferResult = 3
+)
+
+// Enum value maps for CreateOfferResult.
+var (
+	CreateOfferResult_name = map[int32]string{
+		0: "CREATE_OFFER_RESULT_UNSPECIFIED",
+		1: "CREATE_OFFER_RESULT_INSERTED",
+		2: "CREATE_OFFER_RESULT_UPDATED",
+		3: "CREATE_OFFER_RESULT_SKIPPED",
+	}
+	CreateOfferResult_value = map[string]int32{
+		"CREATE_OFFER_RESULT_UNSPECIFIED": 0,
+		"CREATE_OFFER_RESULT_INSERTED":    1,
+		"CREATE_OFFER_RESULT_UPDATED":     2,
+		"CREATE_OFFER_RESULT_SKIPPED":     3,
+	}
+)
+
+func (x CreateOfferResult) Enum() *CreateOfferResult {
+	p := new(CreateOfferResult)
+	*p = x
+	return p
+}
+
+func (x CreateOfferResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateOfferResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[2].Descriptor()
+}
+
+func (CreateOfferResult) Type() protoreflect.EnumType {
+	return &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[2]
+}
+
+func (x CreateOfferResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateOfferResult.Descriptor instead.
+func (CreateOfferResult) EnumDescriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{2}
+}
+
+// OfferStatsDimension - Offer field to group the statistics by
+type OfferStatsDimension int32
+
+const (
+	OfferStatsDimension_OFFER_STATS_DIMENSION_UNSPECIFIED OfferStatsDimension = 0
+	OfferStatsDimension_OFFER_STATS_DIMENSION_TEAM        OfferStatsDimension = 1
+	OfferStatsDimension_OFFER_STATS_DIMENSION_GRADE       OfferStatsDimension = 2
+	OfferStatsDimension_OFFER_STATS_DIMENSION_STATUS      OfferStatsDimension = 3
+)
+
+// Enum value maps for OfferStatsDimension.
+var (
+	OfferStatsDimension_name = map[int32]string{
+		0: "OFFER_STATS_DIMENSION_UNSPECIFIED",
+		1: "OFFER_STATS_DIMENSION_TEAM",
+		2: "OFFER_STATS_DIMENSION_GRADE",
+		3: "OFFER_STATS_DIMENSION_STATUS",
+	}
+	OfferStatsDimension_value = map[string]int32{
+		"OFFER_STATS_DIMENSION_UNSPECIFIED": 0,
+		"OFFER_STATS_DIMENSION_TEAM":        1,
+		"OFFER_STATS_DIMENSION_GRADE":       2,
+		"OFFER_STATS_DIMENSION_STATUS":      3,
+	}
+)
+
+func (x OfferStatsDimension) Enum() *OfferStatsDimension {
+	p := new(OfferStatsDimension)
+	*p = x
+	return p
+}
+
+func (x OfferStatsDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OfferStatsDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[3].Descriptor()
+}
+
+func (OfferStatsDimension) Type() protoreflect.EnumType {
+	return &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[3]
+}
+
+func (x OfferStatsDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OfferStatsDimension.Descriptor instead.
+func (OfferStatsDimension) EnumDescriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{3}
+}
+
+// OfferHistoryAction - Kind of the write that produced a revision
+type OfferHistoryAction int32
+
+const (
+	OfferHistoryAction_OFFER_HISTORY_ACTION_UNSPECIFIED OfferHistoryAction = 0
+	OfferHistoryAction_OFFER_HISTORY_ACTION_CREATED     OfferHistoryAction = 1
+	OfferHistoryAction_OFFER_HISTORY_ACTION_UPDATED     OfferHistoryAction = 2
+	OfferHistoryAction_OFFER_HISTORY_ACTION_REMOVED     OfferHistoryAction = 3
+	OfferHistoryAction_OFFER_HISTORY_ACTION_RESTORED    OfferHistoryAction = 4
+)
+
+// Enum value maps for OfferHistoryAction.
+var (
+	OfferHistoryAction_name = map[int32]string{
+		0: "OFFER_HISTORY_ACTION_UNSPECIFIED",
+		1: "OFFER_HISTORY_ACTION_CREATED",
+		2: "OFFER_HISTORY_ACTION_UPDATED",
+		3: "OFFER_HISTORY_ACTION_REMOVED",
+		4: "OFFER_HISTORY_ACTION_RESTORED",
+	}
+	OfferHistoryAction_value = map[string]int32{
+		"OFFER_HISTORY_ACTION_UNSPECIFIED": 0,
+		"OFFER_HISTORY_ACTION_CREATED":     1,
+		"OFFER_HISTORY_ACTION_UPDATED":     2,
+		"OFFER_HISTORY_ACTION_REMOVED":     3,
+		"OFFER_HISTORY_ACTION_RESTORED":    4,
+	}
+)
+
+func (x OfferHistoryAction) Enum() *OfferHistoryAction {
+	p := new(OfferHistoryAction)
+	*p = x
+	return p
+}
+
+func (x OfferHistoryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OfferHistoryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[4].Descriptor()
+}
+
+func (OfferHistoryAction) Type() protoreflect.EnumType {
+	return &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[4]
+}
+
+func (x OfferHistoryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OfferHistoryAction.Descriptor instead.
+func (OfferHistoryAction) EnumDescriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{4}
+}
+
+// TaskType - Method that started the task
+type TaskType int32
+
+const (
+	TaskType_TASK_TYPE_UNSPECIFIED        TaskType = 0
+	TaskType_TASK_TYPE_CREATE_OFFER       TaskType = 1
+	TaskType_TASK_TYPE_MULTI_CREATE_OFFER TaskType = 2
+	TaskType_TASK_TYPE_UPDATE_OFFER       TaskType = 3
+	TaskType_TASK_TYPE_REMOVE_OFFER       TaskType = 4
+)
+
+// Enum value maps for TaskType.
+var (
+	TaskType_name = map[int32]string{
+		0: "TASK_TYPE_UNSPECIFIED",
+		1: "TASK_TYPE_CREATE_OFFER",
+		2: "TASK_TYPE_MULTI_CREATE_OFFER",
+		3: "TASK_TYPE_UPDATE_OFFER",
+		4: "TASK_TYPE_REMOVE_OFFER",
+	}
+	TaskType_value = map[string]int32{
+		"TASK_TYPE_UNSPECIFIED":        0,
+		"TASK_TYPE_CREATE_OFFER":       1,
+		"TASK_TYPE_MULTI_CREATE_OFFER": 2,
+		"TASK_TYPE_UPDATE_OFFER":       3,
+		"TASK_TYPE_REMOVE_OFFER":       4,
+	}
+)
+
+func (x TaskType) Enum() *TaskType {
+	p := new(TaskType)
+	*p = x
+	return p
+}
+
+func (x TaskType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[5].Descriptor()
+}
+
+func (TaskType) Type() protoreflect.EnumType {
+	return &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[5]
+}
+
+func (x TaskType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskType.Descriptor instead.
+func (TaskType) EnumDescriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{5}
+}
+
+// TaskStatus - Stage of the task processing
+type TaskStatus int32
+
+const (
+	TaskStatus_TASK_STATUS_UNSPECIFIED TaskStatus = 0
+	// The messages of the task are sent to Kafka but not handled yet
+	TaskStatus_TASK_STATUS_QUEUED     TaskStatus = 1
+	TaskStatus_TASK_STATUS_PROCESSING TaskStatus = 2
+	TaskStatus_TASK_STATUS_SUCCEEDED  TaskStatus = 3
+	// The reason of the task holds the error
+	TaskStatus_TASK_STATUS_FAILED TaskStatus = 4
+)
+
+// Enum value maps for TaskStatus.
+var (
+	TaskStatus_name = map[int32]string{
+		0: "TASK_STATUS_UNSPECIFIED",
+		1: "TASK_STATUS_QUEUED",
+		2: "TASK_STATUS_PROCESSING",
+		3: "TASK_STATUS_SUCCEEDED",
+		4: "TASK_STATUS_FAILED",
+	}
+	TaskStatus_value = map[string]int32{
+		"TASK_STATUS_UNSPECIFIED": 0,
+		"TASK_STATUS_QUEUED":      1,
+		"TASK_STATUS_PROCESSING":  2,
+		"TASK_STATUS_SUCCEEDED":   3,
+		"TASK_STATUS_FAILED":      4,
+	}
+)
+
+func (x TaskStatus) Enum() *TaskStatus {
+	p := new(TaskStatus)
+	*p = x
+	return p
+}
+
+func (x TaskStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[6].Descriptor()
+}
+
+func (TaskStatus) Type() protoreflect.EnumType {
+	return &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes[6]
+}
+
+func (x TaskStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskStatus.Descriptor instead.
+func (TaskStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{6}
+}
+
 // Offer ...
 type Offer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Grade  uint64 `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
-	TeamId uint64 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Id     uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId uint64      `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Grade  uint64      `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	TeamId uint64      `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Status OfferStatus `protobuf:"varint,5,opt,name=status,proto3,enum=ozoncp.ocp_offer_api.v1.OfferStatus" json:"status,omitempty"`
+	// The moment after which a sent offer without an answer is expired
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Incremented on every change of the offer, returned by the gateway as
+	// `ETag`
+	Version   uint64                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
 func (x *Offer) Reset() {
@@ -94,6 +508,41 @@ func (x *Offer) GetTeamId() uint64 {
 	return 0
 }
 
+func (x *Offer) GetStatus() OfferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OfferStatus_OFFER_STATUS_UNSPECIFIED
+}
+
+func (x *Offer) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Offer) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Offer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Offer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // CreateOfferV1Request - create offer. Fields are validated
 type CreateOfferV1Request struct {
 	state         protoimpl.MessageState
@@ -103,6 +552,10 @@ type CreateOfferV1Request struct {
 	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Grade  uint64 `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
 	TeamId uint64 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Optional, the offer never expires if it is not set
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Not used in MultiCreateOfferV1Request, the policy of the request applies
+	ConflictPolicy ConflictPolicy `protobuf:"varint,6,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=ozoncp.ocp_offer_api.v1.ConflictPolicy" json:"conflict_policy,omitempty"`
 }
 
 func (x *CreateOfferV1Request) Reset() {
@@ -158,13 +611,29 @@ func (x *CreateOfferV1Request) GetTeamId() uint64 {
 	return 0
 }
 
+func (x *CreateOfferV1Request) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateOfferV1Request) GetConflictPolicy() ConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ConflictPolicy_CONFLICT_POLICY_UNSPECIFIED
+}
+
 // CreateOfferV1Response ...
 type CreateOfferV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Id of the inserted or existing offer
+	Id     uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Result CreateOfferResult `protobuf:"varint,2,opt,name=result,proto3,enum=ozoncp.ocp_offer_api.v1.CreateOfferResult" json:"result,omitempty"`
 }
 
 func (x *CreateOfferV1Response) Reset() {
@@ -206,6 +675,13 @@ func (x *CreateOfferV1Response) GetId() uint64 {
 	return 0
 }
 
+func (x *CreateOfferV1Response) GetResult() CreateOfferResult {
+	if x != nil {
+		return x.Result
+	}
+	return CreateOfferResult_CREATE_OFFER_RESULT_UNSPECIFIED
+}
+
 // TaskCreateOfferV1Request - create offer. Fields are validated
 type TaskCreateOfferV1Request struct {
 	state         protoimpl.MessageState
@@ -275,6 +751,9 @@ type TaskCreateOfferV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Id of the task for GetTaskStatusV1
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
 func (x *TaskCreateOfferV1Response) Reset() {
@@ -309,6 +788,13 @@ func (*TaskCreateOfferV1Response) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *TaskCreateOfferV1Response) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
 // MultiCreateOfferV1Request ...
 type MultiCreateOfferV1Request struct {
 	state         protoimpl.MessageState
@@ -316,6 +802,11 @@ type MultiCreateOfferV1Request struct {
 	unknownFields protoimpl.UnknownFields
 
 	Offers []*CreateOfferV1Request `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	// By default nothing is created if any offer fails.
+	// In the best effort mode the failed offers are reported in the errors
+	// and the others are created
+	BestEffort     bool           `protobuf:"varint,2,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	ConflictPolicy ConflictPolicy `protobuf:"varint,3,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=ozoncp.ocp_offer_api.v1.ConflictPolicy" json:"conflict_policy,omitempty"`
 }
 
 func (x *MultiCreateOfferV1Request) Reset() {
@@ -357,13 +848,35 @@ func (x *MultiCreateOfferV1Request) GetOffers() []*CreateOfferV1Request {
 	return nil
 }
 
+func (x *MultiCreateOfferV1Request) GetBestEffort() bool {
+	if x != nil {
+		return x.BestEffort
+	}
+	return false
+}
+
+func (x *MultiCreateOfferV1Request) GetConflictPolicy() ConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ConflictPolicy_CONFLICT_POLICY_UNSPECIFIED
+}
+
 // MultiCreateOfferV1Response ...
 type MultiCreateOfferV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Number of inserted offers
 	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Ids of the inserted or existing offers in the order of the request,
+	// 0 for a failed offer
+	Ids []uint64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Failed offers, only in the best effort mode
+	Errors []*OfferError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Results of the offers in the order of the request
+	Results []CreateOfferResult `protobuf:"varint,4,rep,packed,name=results,proto3,enum=ozoncp.ocp_offer_api.v1.CreateOfferResult" json:"results,omitempty"`
 }
 
 func (x *MultiCreateOfferV1Response) Reset() {
@@ -405,18 +918,42 @@ func (x *MultiCreateOfferV1Response) GetCount() uint64 {
 	return 0
 }
 
-// TaskMultiCreateOfferV1Request ...
-type TaskMultiCreateOfferV1Request struct {
+func (x *MultiCreateOfferV1Response) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *MultiCreateOfferV1Response) GetErrors() []*OfferError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *MultiCreateOfferV1Response) GetResults() []CreateOfferResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// OfferError - Error of a single offer of a multiple request
+type OfferError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offers    []*CreateOfferV1Request `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
-	BatchSize uint64                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Index of the offer in the request
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// gRPC status code name, e.g. AlreadyExists
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *TaskMultiCreateOfferV1Request) Reset() {
-	*x = TaskMultiCreateOfferV1Request{}
+func (x *OfferError) Reset() {
+	*x = OfferError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -424,13 +961,13 @@ func (x *TaskMultiCreateOfferV1Request) Reset() {
 	}
 }
 
-func (x *TaskMultiCreateOfferV1Request) String() string {
+func (x *OfferError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskMultiCreateOfferV1Request) ProtoMessage() {}
+func (*OfferError) ProtoMessage() {}
 
-func (x *TaskMultiCreateOfferV1Request) ProtoReflect() protoreflect.Message {
+func (x *OfferError) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,34 +979,44 @@ func (x *TaskMultiCreateOfferV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskMultiCreateOfferV1Request.ProtoReflect.Descriptor instead.
-func (*TaskMultiCreateOfferV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use OfferError.ProtoReflect.Descriptor instead.
+func (*OfferError) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TaskMultiCreateOfferV1Request) GetOffers() []*CreateOfferV1Request {
+func (x *OfferError) GetIndex() uint32 {
 	if x != nil {
-		return x.Offers
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *TaskMultiCreateOfferV1Request) GetBatchSize() uint64 {
+func (x *OfferError) GetCode() string {
 	if x != nil {
-		return x.BatchSize
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-// TaskMultiCreateOfferV1Response ...
-type TaskMultiCreateOfferV1Response struct {
+func (x *OfferError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TaskMultiCreateOfferV1Request ...
+type TaskMultiCreateOfferV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Offers    []*CreateOfferV1Request `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	BatchSize uint64                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 }
 
-func (x *TaskMultiCreateOfferV1Response) Reset() {
-	*x = TaskMultiCreateOfferV1Response{}
+func (x *TaskMultiCreateOfferV1Request) Reset() {
+	*x = TaskMultiCreateOfferV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -477,13 +1024,13 @@ func (x *TaskMultiCreateOfferV1Response) Reset() {
 	}
 }
 
-func (x *TaskMultiCreateOfferV1Response) String() string {
+func (x *TaskMultiCreateOfferV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskMultiCreateOfferV1Response) ProtoMessage() {}
+func (*TaskMultiCreateOfferV1Request) ProtoMessage() {}
 
-func (x *TaskMultiCreateOfferV1Response) ProtoReflect() protoreflect.Message {
+func (x *TaskMultiCreateOfferV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -495,22 +1042,37 @@ func (x *TaskMultiCreateOfferV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskMultiCreateOfferV1Response.ProtoReflect.Descriptor instead.
-func (*TaskMultiCreateOfferV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskMultiCreateOfferV1Request.ProtoReflect.Descriptor instead.
+func (*TaskMultiCreateOfferV1Request) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{8}
 }
 
-// DescribeOfferV1Request - get offer by `id`. Fields are validated
-type DescribeOfferV1Request struct {
+func (x *TaskMultiCreateOfferV1Request) GetOffers() []*CreateOfferV1Request {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+func (x *TaskMultiCreateOfferV1Request) GetBatchSize() uint64 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// TaskMultiCreateOfferV1Response ...
+type TaskMultiCreateOfferV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Id of the task for GetTaskStatusV1
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func (x *DescribeOfferV1Request) Reset() {
-	*x = DescribeOfferV1Request{}
+func (x *TaskMultiCreateOfferV1Response) Reset() {
+	*x = TaskMultiCreateOfferV1Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -518,13 +1080,13 @@ func (x *DescribeOfferV1Request) Reset() {
 	}
 }
 
-func (x *DescribeOfferV1Request) String() string {
+func (x *TaskMultiCreateOfferV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeOfferV1Request) ProtoMessage() {}
+func (*TaskMultiCreateOfferV1Response) ProtoMessage() {}
 
-func (x *DescribeOfferV1Request) ProtoReflect() protoreflect.Message {
+func (x *TaskMultiCreateOfferV1Response) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -536,29 +1098,29 @@ func (x *DescribeOfferV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeOfferV1Request.ProtoReflect.Descriptor instead.
-func (*DescribeOfferV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskMultiCreateOfferV1Response.ProtoReflect.Descriptor instead.
+func (*TaskMultiCreateOfferV1Response) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DescribeOfferV1Request) GetId() uint64 {
+func (x *TaskMultiCreateOfferV1Response) GetTaskId() string {
 	if x != nil {
-		return x.Id
+		return x.TaskId
 	}
-	return 0
+	return ""
 }
 
-// DescribeOfferV1Response ...
-type DescribeOfferV1Response struct {
+// BatchGetOffersV1Request - Fields are validated
+type BatchGetOffersV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offer *Offer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *DescribeOfferV1Response) Reset() {
-	*x = DescribeOfferV1Response{}
+func (x *BatchGetOffersV1Request) Reset() {
+	*x = BatchGetOffersV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,13 +1128,13 @@ func (x *DescribeOfferV1Response) Reset() {
 	}
 }
 
-func (x *DescribeOfferV1Response) String() string {
+func (x *BatchGetOffersV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeOfferV1Response) ProtoMessage() {}
+func (*BatchGetOffersV1Request) ProtoMessage() {}
 
-func (x *DescribeOfferV1Response) ProtoReflect() protoreflect.Message {
+func (x *BatchGetOffersV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -584,29 +1146,32 @@ func (x *DescribeOfferV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeOfferV1Response.ProtoReflect.Descriptor instead.
-func (*DescribeOfferV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetOffersV1Request.ProtoReflect.Descriptor instead.
+func (*BatchGetOffersV1Request) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DescribeOfferV1Response) GetOffer() *Offer {
+func (x *BatchGetOffersV1Request) GetIds() []uint64 {
 	if x != nil {
-		return x.Offer
+		return x.Ids
 	}
 	return nil
 }
 
-// ListOfferV1Request - Fields are validated
-type ListOfferV1Request struct {
+// BatchGetOffersV1Response ...
+type BatchGetOffersV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *PaginationInput `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Found offers in the order of the requested ids
+	Offers []*Offer `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	// Requested ids of missing or removed offers
+	NotFoundIds []uint64 `protobuf:"varint,2,rep,packed,name=not_found_ids,json=notFoundIds,proto3" json:"not_found_ids,omitempty"`
 }
 
-func (x *ListOfferV1Request) Reset() {
-	*x = ListOfferV1Request{}
+func (x *BatchGetOffersV1Response) Reset() {
+	*x = BatchGetOffersV1Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -614,13 +1179,13 @@ func (x *ListOfferV1Request) Reset() {
 	}
 }
 
-func (x *ListOfferV1Request) String() string {
+func (x *BatchGetOffersV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfferV1Request) ProtoMessage() {}
+func (*BatchGetOffersV1Response) ProtoMessage() {}
 
-func (x *ListOfferV1Request) ProtoReflect() protoreflect.Message {
+func (x *BatchGetOffersV1Response) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,30 +1197,36 @@ func (x *ListOfferV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfferV1Request.ProtoReflect.Descriptor instead.
-func (*ListOfferV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetOffersV1Response.ProtoReflect.Descriptor instead.
+func (*BatchGetOffersV1Response) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListOfferV1Request) GetPagination() *PaginationInput {
+func (x *BatchGetOffersV1Response) GetOffers() []*Offer {
 	if x != nil {
-		return x.Pagination
+		return x.Offers
 	}
 	return nil
 }
 
-// ListOfferV1Response ...
-type ListOfferV1Response struct {
+func (x *BatchGetOffersV1Response) GetNotFoundIds() []uint64 {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+// DescribeOfferV1Request - get offer by `id`. Fields are validated
+type DescribeOfferV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *PaginationInfo `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Offers     []*Offer        `protobuf:"bytes,2,rep,name=offers,proto3" json:"offers,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListOfferV1Response) Reset() {
-	*x = ListOfferV1Response{}
+func (x *DescribeOfferV1Request) Reset() {
+	*x = DescribeOfferV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,13 +1234,13 @@ func (x *ListOfferV1Response) Reset() {
 	}
 }
 
-func (x *ListOfferV1Response) String() string {
+func (x *DescribeOfferV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfferV1Response) ProtoMessage() {}
+func (*DescribeOfferV1Request) ProtoMessage() {}
 
-func (x *ListOfferV1Response) ProtoReflect() protoreflect.Message {
+func (x *DescribeOfferV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,39 +1252,29 @@ func (x *ListOfferV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfferV1Response.ProtoReflect.Descriptor instead.
-func (*ListOfferV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeOfferV1Request.ProtoReflect.Descriptor instead.
+func (*DescribeOfferV1Request) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListOfferV1Response) GetPagination() *PaginationInfo {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *ListOfferV1Response) GetOffers() []*Offer {
+func (x *DescribeOfferV1Request) GetId() uint64 {
 	if x != nil {
-		return x.Offers
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// UpdateOfferV1Request - update offer `by` id, fields are validated
-type UpdateOfferV1Request struct {
+// DescribeOfferV1Response ...
+type DescribeOfferV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Grade  uint64 `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
-	TeamId uint64 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Offer *Offer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
 }
 
-func (x *UpdateOfferV1Request) Reset() {
-	*x = UpdateOfferV1Request{}
+func (x *DescribeOfferV1Response) Reset() {
+	*x = DescribeOfferV1Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,13 +1282,13 @@ func (x *UpdateOfferV1Request) Reset() {
 	}
 }
 
-func (x *UpdateOfferV1Request) String() string {
+func (x *DescribeOfferV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOfferV1Request) ProtoMessage() {}
+func (*DescribeOfferV1Response) ProtoMessage() {}
 
-func (x *UpdateOfferV1Request) ProtoReflect() protoreflect.Message {
+func (x *DescribeOfferV1Response) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -739,48 +1300,38 @@ func (x *UpdateOfferV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOfferV1Request.ProtoReflect.Descriptor instead.
-func (*UpdateOfferV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeOfferV1Response.ProtoReflect.Descriptor instead.
+func (*DescribeOfferV1Response) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UpdateOfferV1Request) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateOfferV1Request) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *UpdateOfferV1Request) GetGrade() uint64 {
-	if x != nil {
-		return x.Grade
-	}
-	return 0
-}
-
-func (x *UpdateOfferV1Request) GetTeamId() uint64 {
+func (x *DescribeOfferV1Response) GetOffer() *Offer {
 	if x != nil {
-		return x.TeamId
+		return x.Offer
 	}
-	return 0
+	return nil
 }
 
-// UpdateOfferV1Response ...
-type UpdateOfferV1Response struct {
+// GetOfferStatsV1Request - Fields are validated
+type GetOfferStatsV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Groups are ordered by the dimensions in the given order
+	GroupBy []OfferStatsDimension `protobuf:"varint,1,rep,packed,name=group_by,json=groupBy,proto3,enum=ozoncp.ocp_offer_api.v1.OfferStatsDimension" json:"group_by,omitempty"`
+	// Optional, 0 - all teams
+	TeamId uint64 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Optional, offers created at or after this moment
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Optional, offers created before this moment
+	CreatedTo *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// Removed offers are counted too
+	IncludeDeleted bool `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
 }
 
-func (x *UpdateOfferV1Response) Reset() {
-	*x = UpdateOfferV1Response{}
+func (x *GetOfferStatsV1Request) Reset() {
+	*x = GetOfferStatsV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,13 +1339,13 @@ func (x *UpdateOfferV1Response) Reset() {
 	}
 }
 
-func (x *UpdateOfferV1Response) String() string {
+func (x *GetOfferStatsV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOfferV1Response) ProtoMessage() {}
+func (*GetOfferStatsV1Request) ProtoMessage() {}
 
-func (x *UpdateOfferV1Response) ProtoReflect() protoreflect.Message {
+func (x *GetOfferStatsV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -806,25 +1357,60 @@ func (x *UpdateOfferV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOfferV1Response.ProtoReflect.Descriptor instead.
-func (*UpdateOfferV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOfferStatsV1Request.ProtoReflect.Descriptor instead.
+func (*GetOfferStatsV1Request) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{14}
 }
 
-// TaskUpdateOfferV1Request - update offer `by` id, fields are validated
-type TaskUpdateOfferV1Request struct {
+func (x *GetOfferStatsV1Request) GetGroupBy() []OfferStatsDimension {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *GetOfferStatsV1Request) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GetOfferStatsV1Request) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetOfferStatsV1Request) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetOfferStatsV1Request) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// OfferStatsGroup - only the fields of the requested dimensions are set
+type OfferStatsGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Grade  uint64 `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
-	TeamId uint64 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamId uint64      `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Grade  uint64      `protobuf:"varint,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	Status OfferStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ozoncp.ocp_offer_api.v1.OfferStatus" json:"status,omitempty"`
+	Count  uint64      `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *TaskUpdateOfferV1Request) Reset() {
-	*x = TaskUpdateOfferV1Request{}
+func (x *OfferStatsGroup) Reset() {
+	*x = OfferStatsGroup{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -832,13 +1418,13 @@ func (x *TaskUpdateOfferV1Request) Reset() {
 	}
 }
 
-func (x *TaskUpdateOfferV1Request) String() string {
+func (x *OfferStatsGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskUpdateOfferV1Request) ProtoMessage() {}
+func (*OfferStatsGroup) ProtoMessage() {}
 
-func (x *TaskUpdateOfferV1Request) ProtoReflect() protoreflect.Message {
+func (x *OfferStatsGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -850,48 +1436,52 @@ func (x *TaskUpdateOfferV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskUpdateOfferV1Request.ProtoReflect.Descriptor instead.
-func (*TaskUpdateOfferV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use OfferStatsGroup.ProtoReflect.Descriptor instead.
+func (*OfferStatsGroup) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TaskUpdateOfferV1Request) GetId() uint64 {
+func (x *OfferStatsGroup) GetTeamId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.TeamId
 	}
 	return 0
 }
 
-func (x *TaskUpdateOfferV1Request) GetUserId() uint64 {
+func (x *OfferStatsGroup) GetGrade() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Grade
 	}
 	return 0
 }
 
-func (x *TaskUpdateOfferV1Request) GetGrade() uint64 {
+func (x *OfferStatsGroup) GetStatus() OfferStatus {
 	if x != nil {
-		return x.Grade
+		return x.Status
 	}
-	return 0
+	return OfferStatus_OFFER_STATUS_UNSPECIFIED
 }
 
-func (x *TaskUpdateOfferV1Request) GetTeamId() uint64 {
+func (x *OfferStatsGroup) GetCount() uint64 {
 	if x != nil {
-		return x.TeamId
+		return x.Count
 	}
 	return 0
 }
 
-// TaskUpdateOfferV1Response ...
-type TaskUpdateOfferV1Response struct {
+// GetOfferStatsV1Response ...
+type GetOfferStatsV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Groups []*OfferStatsGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// Sum of the group counts
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *TaskUpdateOfferV1Response) Reset() {
-	*x = TaskUpdateOfferV1Response{}
+func (x *GetOfferStatsV1Response) Reset() {
+	*x = GetOfferStatsV1Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -899,13 +1489,13 @@ func (x *TaskUpdateOfferV1Response) Reset() {
 	}
 }
 
-func (x *TaskUpdateOfferV1Response) String() string {
+func (x *GetOfferStatsV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskUpdateOfferV1Response) ProtoMessage() {}
+func (*GetOfferStatsV1Response) ProtoMessage() {}
 
-func (x *TaskUpdateOfferV1Response) ProtoReflect() protoreflect.Message {
+func (x *GetOfferStatsV1Response) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -917,13 +1507,28 @@ func (x *TaskUpdateOfferV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskUpdateOfferV1Response.ProtoReflect.Descriptor instead.
-func (*TaskUpdateOfferV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOfferStatsV1Response.ProtoReflect.Descriptor instead.
+func (*GetOfferStatsV1Response) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{16}
 }
 
-// RemoveOfferV1Request - remove offer by `id`. Fields are validated
-type RemoveOfferV1Request struct {
+func (x *GetOfferStatsV1Response) GetGroups() []*OfferStatsGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetOfferStatsV1Response) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetOfferHistoryV1Request - get history of the offer by `id`. Fields are
+// validated
+type GetOfferHistoryV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -931,8 +1536,8 @@ type RemoveOfferV1Request struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *RemoveOfferV1Request) Reset() {
-	*x = RemoveOfferV1Request{}
+func (x *GetOfferHistoryV1Request) Reset() {
+	*x = GetOfferHistoryV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -940,13 +1545,13 @@ func (x *RemoveOfferV1Request) Reset() {
 	}
 }
 
-func (x *RemoveOfferV1Request) String() string {
+func (x *GetOfferHistoryV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveOfferV1Request) ProtoMessage() {}
+func (*GetOfferHistoryV1Request) ProtoMessage() {}
 
-func (x *RemoveOfferV1Request) ProtoReflect() protoreflect.Message {
+func (x *GetOfferHistoryV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -958,42 +1563,1542 @@ func (x *RemoveOfferV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveOfferV1Request.ProtoReflect.Descriptor instead.
-func (*RemoveOfferV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOfferHistoryV1Request.ProtoReflect.Descriptor instead.
+func (*GetOfferHistoryV1Request) Descriptor() ([]byte, []int) {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RemoveOfferV1Request) GetId() uint64 {
+func (x *GetOfferHistoryV1Request) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// RemoveOfferV1Response ...
-type RemoveOfferV1Response struct {
+// GetOfferHistoryV1Response - revisions ordered from the oldest to the newest
+type GetOfferHistoryV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Revisions []*OfferRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
 }
 
-func (x *RemoveOfferV1Response) Reset() {
+func (x *GetOfferHistoryV1Response) Reset() {
+	*x = GetOfferHistoryV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOfferHistoryV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfferHistoryV1Response) ProtoMessage() {}
+
+func (x *GetOfferHistoryV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfferHistoryV1Response.ProtoReflect.Descriptor instead.
+func (*GetOfferHistoryV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetOfferHistoryV1Response) GetRevisions() []*OfferRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// OfferFieldChange - Old and new value of a single offer field
+type OfferFieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (x *OfferFieldChange) Reset() {
+	*x = OfferFieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferFieldChange) ProtoMessage() {}
+
+func (x *OfferFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferFieldChange.ProtoReflect.Descriptor instead.
+func (*OfferFieldChange) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OfferFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *OfferFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *OfferFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// OfferRevision - A single change of the offer
+type OfferRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sequence number of the revision within the offer, starts with 1
+	Revision uint64             `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Action   OfferHistoryAction `protobuf:"varint,2,opt,name=action,proto3,enum=ozoncp.ocp_offer_api.v1.OfferHistoryAction" json:"action,omitempty"`
+	// Who made the change, taken from the `x-actor` header
+	Actor     string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	Changes   []*OfferFieldChange    `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *OfferRevision) Reset() {
+	*x = OfferRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferRevision) ProtoMessage() {}
+
+func (x *OfferRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferRevision.ProtoReflect.Descriptor instead.
+func (*OfferRevision) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OfferRevision) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *OfferRevision) GetAction() OfferHistoryAction {
+	if x != nil {
+		return x.Action
+	}
+	return OfferHistoryAction_OFFER_HISTORY_ACTION_UNSPECIFIED
+}
+
+func (x *OfferRevision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *OfferRevision) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *OfferRevision) GetChanges() []*OfferFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ListOfferV1Request - Fields are validated
+type ListOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *PaginationInput `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional, the gateway takes it from the `filter.*` query parameters
+	Filter *OfferFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional, `<field> [asc|desc]`, the field is one of `id`, `user_id`,
+	// `team_id`, `grade`, `created_at` and `updated_at`. Offers with equal
+	// fields are ordered by `id`. Default is `id asc`
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+}
+
+func (x *ListOfferV1Request) Reset() {
+	*x = ListOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOfferV1Request) ProtoMessage() {}
+
+func (x *ListOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOfferV1Request.ProtoReflect.Descriptor instead.
+func (*ListOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListOfferV1Request) GetPagination() *PaginationInput {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListOfferV1Request) GetFilter() *OfferFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListOfferV1Request) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+// ExportOffersV1Request - Fields are validated
+type ExportOffersV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional, the gateway takes it from the `filter.*` query parameters
+	Filter *OfferFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ExportOffersV1Request) Reset() {
+	*x = ExportOffersV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOffersV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOffersV1Request) ProtoMessage() {}
+
+func (x *ExportOffersV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOffersV1Request.ProtoReflect.Descriptor instead.
+func (*ExportOffersV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportOffersV1Request) GetFilter() *OfferFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ExportOffersV1Response - one message per offer
+type ExportOffersV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offer *Offer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *ExportOffersV1Response) Reset() {
+	*x = ExportOffersV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOffersV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOffersV1Response) ProtoMessage() {}
+
+func (x *ExportOffersV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOffersV1Response.ProtoReflect.Descriptor instead.
+func (*ExportOffersV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportOffersV1Response) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+// WatchOffersV1Request - Fields are validated
+type WatchOffersV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional, `resume_token` of the last received change, the changes after
+	// it are streamed first
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Optional, only changes after which the offer belongs to these users
+	UserIds []uint64 `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// Optional, only changes after which the offer belongs to these teams
+	TeamIds []uint64 `protobuf:"varint,3,rep,packed,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`
+}
+
+func (x *WatchOffersV1Request) Reset() {
+	*x = WatchOffersV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOffersV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOffersV1Request) ProtoMessage() {}
+
+func (x *WatchOffersV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOffersV1Request.ProtoReflect.Descriptor instead.
+func (*WatchOffersV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WatchOffersV1Request) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchOffersV1Request) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *WatchOffersV1Request) GetTeamIds() []uint64 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+// WatchOffersV1Response - one message per change
+type WatchOffersV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pass it to a new call to continue after this change
+	ResumeToken string         `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OfferId     uint64         `protobuf:"varint,2,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	Revision    *OfferRevision `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The state of the offer after the revision
+	Offer *Offer `protobuf:"bytes,4,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *WatchOffersV1Response) Reset() {
+	*x = WatchOffersV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOffersV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOffersV1Response) ProtoMessage() {}
+
+func (x *WatchOffersV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOffersV1Response.ProtoReflect.Descriptor instead.
+func (*WatchOffersV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchOffersV1Response) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchOffersV1Response) GetOfferId() uint64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *WatchOffersV1Response) GetRevision() *OfferRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (x *WatchOffersV1Response) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+// OfferFilter - an offer must match all set conditions
+type OfferFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []uint64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	TeamIds []uint64 `protobuf:"varint,2,rep,packed,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`
+	// Inclusive bounds of the grade, 0 - no bound
+	GradeMin uint64 `protobuf:"varint,3,opt,name=grade_min,json=gradeMin,proto3" json:"grade_min,omitempty"`
+	GradeMax uint64 `protobuf:"varint,4,opt,name=grade_max,json=gradeMax,proto3" json:"grade_max,omitempty"`
+	// Removed offers are listed too
+	IncludeDeleted bool `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+}
+
+func (x *OfferFilter) Reset() {
+	*x = OfferFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferFilter) ProtoMessage() {}
+
+func (x *OfferFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferFilter.ProtoReflect.Descriptor instead.
+func (*OfferFilter) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OfferFilter) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *OfferFilter) GetTeamIds() []uint64 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+func (x *OfferFilter) GetGradeMin() uint64 {
+	if x != nil {
+		return x.GradeMin
+	}
+	return 0
+}
+
+func (x *OfferFilter) GetGradeMax() uint64 {
+	if x != nil {
+		return x.GradeMax
+	}
+	return 0
+}
+
+func (x *OfferFilter) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// ListOfferV1Response ...
+type ListOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *PaginationInfo `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Offers     []*Offer        `protobuf:"bytes,2,rep,name=offers,proto3" json:"offers,omitempty"`
+}
+
+func (x *ListOfferV1Response) Reset() {
+	*x = ListOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOfferV1Response) ProtoMessage() {}
+
+func (x *ListOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOfferV1Response.ProtoReflect.Descriptor instead.
+func (*ListOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListOfferV1Response) GetPagination() *PaginationInfo {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListOfferV1Response) GetOffers() []*Offer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+// UpdateOfferV1Request - update offer `by` id, fields are validated
+type UpdateOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Grade  uint64 `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	TeamId uint64 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Optional, the offer never expires if it is not set
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Optional, the update is rejected with `ABORTED` if the offer version
+	// differs. The gateway takes it from the `If-Match` header as well
+	ExpectedVersion uint64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *UpdateOfferV1Request) Reset() {
+	*x = UpdateOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOfferV1Request) ProtoMessage() {}
+
+func (x *UpdateOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOfferV1Request.ProtoReflect.Descriptor instead.
+func (*UpdateOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateOfferV1Request) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateOfferV1Request) GetGrade() uint64 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *UpdateOfferV1Request) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *UpdateOfferV1Request) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UpdateOfferV1Request) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// UpdateOfferV1Response ...
+type UpdateOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateOfferV1Response) Reset() {
+	*x = UpdateOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOfferV1Response) ProtoMessage() {}
+
+func (x *UpdateOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOfferV1Response.ProtoReflect.Descriptor instead.
+func (*UpdateOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{29}
+}
+
+// PatchOfferV1Request - update the offer fields listed in `update_mask`.
+// Only `user_id`, `team_id`, `grade` and `expires_at` may be listed, the
+// listed fields are validated like in `UpdateOfferV1Request`. The gateway
+// builds the mask from the body if it is not set
+type PatchOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offer      *Offer                 `protobuf:"bytes,2,opt,name=offer,proto3" json:"offer,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Optional, the update is rejected with `ABORTED` if the offer version
+	// differs. The gateway takes it from the `If-Match` header as well
+	ExpectedVersion uint64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *PatchOfferV1Request) Reset() {
+	*x = PatchOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchOfferV1Request) ProtoMessage() {}
+
+func (x *PatchOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchOfferV1Request.ProtoReflect.Descriptor instead.
+func (*PatchOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PatchOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchOfferV1Request) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *PatchOfferV1Request) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *PatchOfferV1Request) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// PatchOfferV1Response ...
+type PatchOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PatchOfferV1Response) Reset() {
+	*x = PatchOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchOfferV1Response) ProtoMessage() {}
+
+func (x *PatchOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchOfferV1Response.ProtoReflect.Descriptor instead.
+func (*PatchOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{31}
+}
+
+// SendOfferV1Request - send offer by `id`. Fields are validated
+type SendOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SendOfferV1Request) Reset() {
+	*x = SendOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOfferV1Request) ProtoMessage() {}
+
+func (x *SendOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendOfferV1Request.ProtoReflect.Descriptor instead.
+func (*SendOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SendOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// SendOfferV1Response ...
+type SendOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendOfferV1Response) Reset() {
+	*x = SendOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOfferV1Response) ProtoMessage() {}
+
+func (x *SendOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendOfferV1Response.ProtoReflect.Descriptor instead.
+func (*SendOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{33}
+}
+
+// AcceptOfferV1Request - accept offer by `id`. Fields are validated
+type AcceptOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AcceptOfferV1Request) Reset() {
+	*x = AcceptOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOfferV1Request) ProtoMessage() {}
+
+func (x *AcceptOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOfferV1Request.ProtoReflect.Descriptor instead.
+func (*AcceptOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AcceptOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// AcceptOfferV1Response ...
+type AcceptOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AcceptOfferV1Response) Reset() {
+	*x = AcceptOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOfferV1Response) ProtoMessage() {}
+
+func (x *AcceptOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOfferV1Response.ProtoReflect.Descriptor instead.
+func (*AcceptOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{35}
+}
+
+// DeclineOfferV1Request - decline offer by `id`. Fields are validated
+type DeclineOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeclineOfferV1Request) Reset() {
+	*x = DeclineOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineOfferV1Request) ProtoMessage() {}
+
+func (x *DeclineOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineOfferV1Request.ProtoReflect.Descriptor instead.
+func (*DeclineOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeclineOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeclineOfferV1Response ...
+type DeclineOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeclineOfferV1Response) Reset() {
+	*x = DeclineOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineOfferV1Response) ProtoMessage() {}
+
+func (x *DeclineOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineOfferV1Response.ProtoReflect.Descriptor instead.
+func (*DeclineOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{37}
+}
+
+// WithdrawOfferV1Request - withdraw offer by `id`. Fields are validated
+type WithdrawOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WithdrawOfferV1Request) Reset() {
+	*x = WithdrawOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawOfferV1Request) ProtoMessage() {}
+
+func (x *WithdrawOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawOfferV1Request.ProtoReflect.Descriptor instead.
+func (*WithdrawOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WithdrawOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// WithdrawOfferV1Response ...
+type WithdrawOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WithdrawOfferV1Response) Reset() {
+	*x = WithdrawOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawOfferV1Response) ProtoMessage() {}
+
+func (x *WithdrawOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawOfferV1Response.ProtoReflect.Descriptor instead.
+func (*WithdrawOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{39}
+}
+
+// TaskUpdateOfferV1Request - update offer `by` id, fields are validated.
+// The expiration of the offer is kept
+type TaskUpdateOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Grade  uint64 `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	TeamId uint64 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+}
+
+func (x *TaskUpdateOfferV1Request) Reset() {
+	*x = TaskUpdateOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskUpdateOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskUpdateOfferV1Request) ProtoMessage() {}
+
+func (x *TaskUpdateOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskUpdateOfferV1Request.ProtoReflect.Descriptor instead.
+func (*TaskUpdateOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TaskUpdateOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskUpdateOfferV1Request) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TaskUpdateOfferV1Request) GetGrade() uint64 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *TaskUpdateOfferV1Request) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+// TaskUpdateOfferV1Response ...
+type TaskUpdateOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the task for GetTaskStatusV1
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (x *TaskUpdateOfferV1Response) Reset() {
+	*x = TaskUpdateOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskUpdateOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskUpdateOfferV1Response) ProtoMessage() {}
+
+func (x *TaskUpdateOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskUpdateOfferV1Response.ProtoReflect.Descriptor instead.
+func (*TaskUpdateOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TaskUpdateOfferV1Response) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// RemoveOfferV1Request - remove offer by `id`. Fields are validated
+type RemoveOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional, the removal is rejected with `ABORTED` if the offer version
+	// differs. The gateway takes it from the `If-Match` header as well
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *RemoveOfferV1Request) Reset() {
+	*x = RemoveOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOfferV1Request) ProtoMessage() {}
+
+func (x *RemoveOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOfferV1Request.ProtoReflect.Descriptor instead.
+func (*RemoveOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RemoveOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveOfferV1Request) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// RemoveOfferV1Response ...
+type RemoveOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveOfferV1Response) Reset() {
 	*x = RemoveOfferV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[18]
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOfferV1Response) ProtoMessage() {}
+
+func (x *RemoveOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOfferV1Response.ProtoReflect.Descriptor instead.
+func (*RemoveOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{43}
+}
+
+// RestoreOfferV1Request - restore removed offer by `id`. Fields are validated
+type RestoreOfferV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreOfferV1Request) Reset() {
+	*x = RestoreOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreOfferV1Request) ProtoMessage() {}
+
+func (x *RestoreOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreOfferV1Request.ProtoReflect.Descriptor instead.
+func (*RestoreOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RestoreOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// RestoreOfferV1Response ...
+type RestoreOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreOfferV1Response) Reset() {
+	*x = RestoreOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveOfferV1Response) String() string {
+func (x *RestoreOfferV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveOfferV1Response) ProtoMessage() {}
+func (*RestoreOfferV1Response) ProtoMessage() {}
 
-func (x *RemoveOfferV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[18]
+func (x *RestoreOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,9 +3109,107 @@ func (x *RemoveOfferV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveOfferV1Response.ProtoReflect.Descriptor instead.
-func (*RemoveOfferV1Response) Descriptor() ([]byte, []int) {
-	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RestoreOfferV1Response.ProtoReflect.Descriptor instead.
+func (*RestoreOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{45}
+}
+
+// PurgeOffersV1Request - Fields are validated
+type PurgeOffersV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Offers removed before this moment are deleted, their history is kept
+	DeletedBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=deleted_before,json=deletedBefore,proto3" json:"deleted_before,omitempty"`
+}
+
+func (x *PurgeOffersV1Request) Reset() {
+	*x = PurgeOffersV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeOffersV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeOffersV1Request) ProtoMessage() {}
+
+func (x *PurgeOffersV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeOffersV1Request.ProtoReflect.Descriptor instead.
+func (*PurgeOffersV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PurgeOffersV1Request) GetDeletedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedBefore
+	}
+	return nil
+}
+
+// PurgeOffersV1Response ...
+type PurgeOffersV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of deleted offers
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PurgeOffersV1Response) Reset() {
+	*x = PurgeOffersV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeOffersV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeOffersV1Response) ProtoMessage() {}
+
+func (x *PurgeOffersV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeOffersV1Response.ProtoReflect.Descriptor instead.
+func (*PurgeOffersV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PurgeOffersV1Response) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
 // TaskRemoveOfferV1Request - remove offer by `id`. Fields are validated
@@ -1018,23 +3221,236 @@ type TaskRemoveOfferV1Request struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *TaskRemoveOfferV1Request) Reset() {
-	*x = TaskRemoveOfferV1Request{}
+func (x *TaskRemoveOfferV1Request) Reset() {
+	*x = TaskRemoveOfferV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskRemoveOfferV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRemoveOfferV1Request) ProtoMessage() {}
+
+func (x *TaskRemoveOfferV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRemoveOfferV1Request.ProtoReflect.Descriptor instead.
+func (*TaskRemoveOfferV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TaskRemoveOfferV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// TaskRemoveOfferV1Response ...
+type TaskRemoveOfferV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the task for GetTaskStatusV1
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (x *TaskRemoveOfferV1Response) Reset() {
+	*x = TaskRemoveOfferV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskRemoveOfferV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRemoveOfferV1Response) ProtoMessage() {}
+
+func (x *TaskRemoveOfferV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRemoveOfferV1Response.ProtoReflect.Descriptor instead.
+func (*TaskRemoveOfferV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TaskRemoveOfferV1Response) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// Task - Asynchronous task handled by kafka-consumer
+type Task struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   TaskType   `protobuf:"varint,2,opt,name=type,proto3,enum=ozoncp.ocp_offer_api.v1.TaskType" json:"type,omitempty"`
+	Status TaskStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ozoncp.ocp_offer_api.v1.TaskStatus" json:"status,omitempty"`
+	// Error of the failed task
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Ids of the created, updated or removed offers
+	OfferIds []uint64 `protobuf:"varint,5,rep,packed,name=offer_ids,json=offerIds,proto3" json:"offer_ids,omitempty"`
+	// Number of Kafka messages of the task, TaskMultiCreateOfferV1 sends one
+	// per batch
+	Parts     uint32                 `protobuf:"varint,6,opt,name=parts,proto3" json:"parts,omitempty"`
+	PartsDone uint32                 `protobuf:"varint,7,opt,name=parts_done,json=partsDone,proto3" json:"parts_done,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Task) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Task) GetType() TaskType {
+	if x != nil {
+		return x.Type
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *Task) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *Task) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Task) GetOfferIds() []uint64 {
+	if x != nil {
+		return x.OfferIds
+	}
+	return nil
+}
+
+func (x *Task) GetParts() uint32 {
+	if x != nil {
+		return x.Parts
+	}
+	return 0
+}
+
+func (x *Task) GetPartsDone() uint32 {
+	if x != nil {
+		return x.PartsDone
+	}
+	return 0
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetTaskStatusV1Request - get task by `id`. Fields are validated
+type GetTaskStatusV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetTaskStatusV1Request) Reset() {
+	*x = GetTaskStatusV1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[19]
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskRemoveOfferV1Request) String() string {
+func (x *GetTaskStatusV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskRemoveOfferV1Request) ProtoMessage() {}
+func (*GetTaskStatusV1Request) ProtoMessage() {}
 
-func (x *TaskRemoveOfferV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[19]
+func (x *GetTaskStatusV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,42 +3461,44 @@ func (x *TaskRemoveOfferV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskRemoveOfferV1Request.ProtoReflect.Descriptor instead.
-func (*TaskRemoveOfferV1Request) Descriptor() ([]byte, []int) {
-	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetTaskStatusV1Request.ProtoReflect.Descriptor instead.
+func (*GetTaskStatusV1Request) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *TaskRemoveOfferV1Request) GetId() uint64 {
+func (x *GetTaskStatusV1Request) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-// TaskRemoveOfferV1Response ...
-type TaskRemoveOfferV1Response struct {
+// GetTaskStatusV1Response ...
+type GetTaskStatusV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 }
 
-func (x *TaskRemoveOfferV1Response) Reset() {
-	*x = TaskRemoveOfferV1Response{}
+func (x *GetTaskStatusV1Response) Reset() {
+	*x = GetTaskStatusV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[20]
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskRemoveOfferV1Response) String() string {
+func (x *GetTaskStatusV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskRemoveOfferV1Response) ProtoMessage() {}
+func (*GetTaskStatusV1Response) ProtoMessage() {}
 
-func (x *TaskRemoveOfferV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[20]
+func (x *GetTaskStatusV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,9 +3509,16 @@ func (x *TaskRemoveOfferV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskRemoveOfferV1Response.ProtoReflect.Descriptor instead.
-func (*TaskRemoveOfferV1Response) Descriptor() ([]byte, []int) {
-	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetTaskStatusV1Response.ProtoReflect.Descriptor instead.
+func (*GetTaskStatusV1Response) Descriptor() ([]byte, []int) {
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTaskStatusV1Response) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
 }
 
 // PaginationInfo - Contains information about the current state of pagination
@@ -1102,11 +3527,11 @@ type PaginationInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Current page number
+	// Current page number, 0 if the page is requested by `page_token`
 	Page uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	// Total pages
+	// Total pages, 0 if `skip_count` is set
 	TotalPages uint64 `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	// Total items
+	// Total items, 0 if `skip_count` is set
 	TotalItems uint64 `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
 	// Items per page - max 10k
 	PerPage uint32 `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
@@ -1114,12 +3539,14 @@ type PaginationInfo struct {
 	HasNextPage bool `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
 	// Has previous page
 	HasPreviousPage bool `protobuf:"varint,6,opt,name=has_previous_page,json=hasPreviousPage,proto3" json:"has_previous_page,omitempty"`
+	// Token of the next page for `page_token`, empty on the last page
+	NextPageToken string `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *PaginationInfo) Reset() {
 	*x = PaginationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[21]
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1132,7 +3559,7 @@ func (x *PaginationInfo) String() string {
 func (*PaginationInfo) ProtoMessage() {}
 
 func (x *PaginationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[21]
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1145,7 +3572,7 @@ func (x *PaginationInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationInfo.ProtoReflect.Descriptor instead.
 func (*PaginationInfo) Descriptor() ([]byte, []int) {
-	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{21}
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *PaginationInfo) GetPage() uint64 {
@@ -1190,6 +3617,13 @@ func (x *PaginationInfo) GetHasPreviousPage() bool {
 	return false
 }
 
+func (x *PaginationInfo) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // PaginationInput Offset pagination uses skip and take to skip a certain number
 // of results and select a limited range. Fields are validated
 type PaginationInput struct {
@@ -1197,22 +3631,25 @@ type PaginationInput struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Cursor-based pagination uses cursor and take to return a
-	// limited set of results before or after a given cursor
+	// Deprecated: ignored, use `page_token`
 	//
 	// Deprecated: Do not use.
 	Cursor uint64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	// Number of items per page
 	Take uint32 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
-	// The number of skipped elements, when using the cursor, the counting starts
-	// from the specified id
+	// The number of skipped elements
 	Skip uint64 `protobuf:"varint,3,opt,name=skip,proto3" json:"skip,omitempty"`
+	// Keyset pagination: `next_page_token` of the previous page, the listing
+	// continues after its last item. Can't be combined with `skip`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Don't count the total items, useful for large tables
+	SkipCount bool `protobuf:"varint,5,opt,name=skip_count,json=skipCount,proto3" json:"skip_count,omitempty"`
 }
 
 func (x *PaginationInput) Reset() {
 	*x = PaginationInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[22]
+		mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1225,7 +3662,7 @@ func (x *PaginationInput) String() string {
 func (*PaginationInput) ProtoMessage() {}
 
 func (x *PaginationInput) ProtoReflect() protoreflect.Message {
-	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[22]
+	mi := &file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,7 +3675,7 @@ func (x *PaginationInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationInput.ProtoReflect.Descriptor instead.
 func (*PaginationInput) Descriptor() ([]byte, []int) {
-	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{22}
+	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP(), []int{54}
 }
 
 // Deprecated: Do not use.
@@ -1263,6 +3700,20 @@ func (x *PaginationInput) GetSkip() uint64 {
 	return 0
 }
 
+func (x *PaginationInput) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *PaginationInput) GetSkipCount() bool {
+	if x != nil {
+		return x.SkipCount
+	}
+	return false
+}
+
 var File_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto protoreflect.FileDescriptor
 
 var file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDesc = []byte{
@@ -1272,230 +3723,713 @@ var file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDesc = []byte{
 	0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
 	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a,
-	0x05, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x79,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00,
-	0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20,
-	0x00, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x7d, 0x0a, 0x18, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
-	0x20, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
-	0x64, 0x22, 0x1b, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
-	0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62,
-	0x0a, 0x19, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66,
-	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x6f,
-	0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x7a,
-	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65,
-	0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65,
-	0x72, 0x73, 0x22, 0x32, 0x0a, 0x1a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x1d, 0x54, 0x61, 0x73, 0x6b, 0x4d,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xe8, 0x02, 0x0a, 0x05, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9a, 0x02, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
+	0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x40,
+	0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x5a, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f,
+	0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x6b, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x42, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2a, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7d, 0x0a, 0x18, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x19, 0x4d,
 	0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
 	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65,
 	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
 	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
 	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12,
-	0x26, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x54, 0x61, 0x73, 0x6b, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
-	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x16, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x17,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e,
+	0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74,
+	0x12, 0x5a, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xc7, 0x01, 0x0a,
+	0x1a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70,
+	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x2a, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x50, 0x0a, 0x0a, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1d, 0x54, 0x61, 0x73,
+	0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f,
+	0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x39, 0x0a, 0x1e, 0x54, 0x61, 0x73,
+	0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x73, 0x6b, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x13, 0xfa, 0x42,
+	0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x18, 0x01, 0x22, 0x04, 0x32, 0x02, 0x20,
+	0x00, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x76, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x6f,
+	0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x73, 0x22, 0x31,
+	0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x4f, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x22, 0xb2, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5c, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x2c, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x13, 0xfa,
+	0x42, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x18, 0x01, 0x22, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01,
+	0x20, 0x00, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x0f, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x7a, 0x6f,
+	0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x71,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56,
+	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02,
+	0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e,
 	0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x22, 0x68, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70,
-	0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70,
-	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65,
-	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
-	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73,
-	0x22, 0x92, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72,
-	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x74,
-	0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
-	0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96,
-	0x01, 0x0a, 0x18, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x62, 0x0a, 0x10, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x86, 0x02,
+	0x0a, 0x0d, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x43, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x22, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x22, 0x55, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x16, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70,
+	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x14, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0x18, 0x80, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x2a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0x42, 0x0f, 0xfa, 0x42, 0x0c, 0x92, 0x01, 0x09, 0x10, 0xe8, 0x07, 0x22, 0x04, 0x32,
+	0x02, 0x20, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x08,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0f,
+	0xfa, 0x42, 0x0c, 0x92, 0x01, 0x09, 0x10, 0xe8, 0x07, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
+	0x07, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x15, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70,
+	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x22, 0xc8, 0x01, 0x0a, 0x0b, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0f, 0xfa, 0x42,
+	0x0c, 0x92, 0x01, 0x09, 0x10, 0xe8, 0x07, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0f, 0xfa, 0x42, 0x0c, 0x92, 0x01, 0x09,
+	0x10, 0xe8, 0x07, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4d, 0x69, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4d, 0x61, 0x78, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e,
+	0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x22, 0x82,
+	0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd6, 0x01, 0x0a,
+	0x13, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a,
+	0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x3b, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a,
+	0x12, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13,
+	0x53, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x66, 0x66,
 	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
-	0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66,
-	0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20,
-	0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f,
-	0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
-	0x0a, 0x18, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65,
-	0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xd1, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x72,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x72,
-	0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73,
-	0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f,
-	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x50, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a,
+	0x15, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x18, 0x0a, 0x16, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
+	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x16, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x18, 0x54, 0x61, 0x73, 0x6b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x20,
+	0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x22, 0x34, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32,
+	0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x15, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a,
+	0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x14, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4d, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xb2, 0x01, 0x04, 0x08, 0x01, 0x38, 0x01, 0x52,
+	0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x2d,
+	0x0a, 0x15, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x33, 0x0a,
+	0x18, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x34, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0xea, 0x02, 0x0a, 0x04, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
+	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
+	0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0xf9, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68,
+	0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x63, 0x75, 0x72,
 	0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x04, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0d, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x2a, 0x05, 0x18, 0x90, 0x4e, 0x20, 0x00, 0x52, 0x04, 0x74,
 	0x61, 0x6b, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70,
-	0x32, 0xd4, 0x0b, 0x0a, 0x12, 0x4f, 0x63, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x41, 0x70, 0x69,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
-	0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
-	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
-	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
-	0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12,
-	0x96, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66,
-	0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f,
-	0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
-	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
+	0x12, 0x27, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69,
+	0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x6b, 0x69, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0xc6, 0x01, 0x0a, 0x0b, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x46, 0x46, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x15,
+	0x0a, 0x11, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53,
+	0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x19, 0x0a, 0x15, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x4f,
+	0x46, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x44, 0x52, 0x41, 0x57, 0x4e, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x46, 0x46, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x06, 0x2a, 0x87, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43,
+	0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x45, 0x10, 0x03, 0x2a, 0x9c, 0x01, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45,
+	0x52, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x49, 0x4e,
+	0x53, 0x45, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x9f, 0x01, 0x0a, 0x13, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x21, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x46, 0x46,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x46, 0x46,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x45, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x46,
+	0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x03, 0x2a, 0xc3, 0x01, 0x0a,
+	0x12, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x53,
+	0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x46, 0x46,
+	0x45, 0x52, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4f,
+	0x46, 0x46, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a,
+	0x1c, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x21, 0x0a, 0x1d, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44,
+	0x10, 0x04, 0x2a, 0x9b, 0x01, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x19, 0x0a, 0x15, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x41,
+	0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4f,
+	0x46, 0x46, 0x45, 0x52, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x41, 0x53, 0x4b,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46,
+	0x45, 0x52, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x10, 0x04,
+	0x2a, 0x90, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1b, 0x0a, 0x17, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x12, 0x19, 0x0a, 0x15, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54,
+	0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x04, 0x32, 0xc6, 0x1a, 0x0a, 0x12, 0x4f, 0x63, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x41, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
 	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
 	0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66,
-	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6f,
-	0x66, 0x66, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x9a, 0x01, 0x0a, 0x12, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12,
-	0x32, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
-	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70,
-	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
-	0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x3a, 0x01, 0x2a, 0x12, 0xab, 0x01, 0x0a, 0x16, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31,
-	0x12, 0x36, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66,
-	0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
-	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
-	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x74,
-	0x61, 0x73, 0x6b, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x3a, 0x01, 0x2a, 0x12, 0x8d, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70,
-	0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
-	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
-	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72,
-	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0x7c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
-	0x56, 0x31, 0x12, 0x2b, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
-	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
-	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72,
-	0x73, 0x12, 0x85, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65,
-	0x72, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70,
-	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
-	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x1a, 0x0a, 0x2f, 0x76, 0x31, 0x2f,
-	0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x11, 0x54, 0x61,
-	0x73, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12,
-	0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
-	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
-	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a, 0x0f,
-	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x3a,
-	0x01, 0x2a, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66,
-	0x65, 0x72, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63,
-	0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70,
-	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x76, 0x31,
-	0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x98, 0x01, 0x0a,
-	0x11, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73,
+	0x6b, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x9a, 0x01, 0x0a, 0x12,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x56, 0x31, 0x12, 0x32, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e,
+	0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x3a, 0x01, 0x2a, 0x12, 0xab, 0x01, 0x0a, 0x16, 0x54, 0x61, 0x73,
+	0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x56, 0x31, 0x12, 0x36, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70,
+	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x3a, 0x01, 0x2a, 0x12, 0x8d, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f,
+	0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x94, 0x01, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x12, 0x30, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x12, 0x8e, 0x01,
+	0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31,
+	0x12, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x8a,
+	0x01, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31,
+	0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x8e, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x31, 0x12,
+	0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x3a, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x9b, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
 	0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
-	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f,
 	0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
+	0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56,
+	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x7c, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2b, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e,
+	0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f,
+	0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
+	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x1a, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x8b, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
+	0x31, 0x12, 0x2c, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x32, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x86,
+	0x01, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2b,
+	0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
 	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6f, 0x66, 0x66, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2f, 0x6f, 0x63, 0x70,
-	0x2d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6f,
-	0x63, 0x70, 0x2d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x3b, 0x6f, 0x63, 0x70,
-	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x16, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x3a, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x8e, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
+	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x3a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x92, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x63,
+	0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2e, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x96, 0x01,
+	0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
+	0x31, 0x12, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x18, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x96, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x87, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56,
+	0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x0e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x2e, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x91,
+	0x01, 0x0a, 0x0d, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31,
+	0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x3a, 0x70, 0x75, 0x72, 0x67, 0x65, 0x3a,
+	0x01, 0x2a, 0x12, 0x98, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
+	0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73,
+	0x6b, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8c, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56,
+	0x31, 0x12, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2e, 0x6f, 0x63, 0x70, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x41, 0x5a, 0x3f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x63,
+	0x70, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x2d, 0x61, 0x70,
+	0x69, 0x3b, 0x6f, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1510,64 +4444,166 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescGZIP() []byte {
 	return file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDescData
 }
 
-var file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_goTypes = []interface{}{
-	(*Offer)(nil),                          // 0: ozoncp.ocp_offer_api.v1.Offer
-	(*CreateOfferV1Request)(nil),           // 1: ozoncp.ocp_offer_api.v1.CreateOfferV1Request
-	(*CreateOfferV1Response)(nil),          // 2: ozoncp.ocp_offer_api.v1.CreateOfferV1Response
-	(*TaskCreateOfferV1Request)(nil),       // 3: ozoncp.ocp_offer_api.v1.TaskCreateOfferV1Request
-	(*TaskCreateOfferV1Response)(nil),      // 4: ozoncp.ocp_offer_api.v1.TaskCreateOfferV1Response
-	(*MultiCreateOfferV1Request)(nil),      // 5: ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Request
-	(*MultiCreateOfferV1Response)(nil),     // 6: ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Response
-	(*TaskMultiCreateOfferV1Request)(nil),  // 7: ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Request
-	(*TaskMultiCreateOfferV1Response)(nil), // 8: ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Response
-	(*DescribeOfferV1Request)(nil),         // 9: ozoncp.ocp_offer_api.v1.DescribeOfferV1Request
-	(*DescribeOfferV1Response)(nil),        // 10: ozoncp.ocp_offer_api.v1.DescribeOfferV1Response
-	(*ListOfferV1Request)(nil),             // 11: ozoncp.ocp_offer_api.v1.ListOfferV1Request
-	(*ListOfferV1Response)(nil),            // 12: ozoncp.ocp_offer_api.v1.ListOfferV1Response
-	(*UpdateOfferV1Request)(nil),           // 13: ozoncp.ocp_offer_api.v1.UpdateOfferV1Request
-	(*UpdateOfferV1Response)(nil),          // 14: ozoncp.ocp_offer_api.v1.UpdateOfferV1Response
-	(*TaskUpdateOfferV1Request)(nil),       // 15: ozoncp.ocp_offer_api.v1.TaskUpdateOfferV1Request
-	(*TaskUpdateOfferV1Response)(nil),      // 16: ozoncp.ocp_offer_api.v1.TaskUpdateOfferV1Response
-	(*RemoveOfferV1Request)(nil),           // 17: ozoncp.ocp_offer_api.v1.RemoveOfferV1Request
-	(*RemoveOfferV1Response)(nil),          // 18: ozoncp.ocp_offer_api.v1.RemoveOfferV1Response
-	(*TaskRemoveOfferV1Request)(nil),       // 19: ozoncp.ocp_offer_api.v1.TaskRemoveOfferV1Request
-	(*TaskRemoveOfferV1Response)(nil),      // 20: ozoncp.ocp_offer_api.v1.TaskRemoveOfferV1Response
-	(*PaginationInfo)(nil),                 // 21: ozoncp.ocp_offer_api.v1.PaginationInfo
-	(*PaginationInput)(nil),                // 22: ozoncp.ocp_offer_api.v1.PaginationInput
+	(OfferStatus)(0),                       // 0: ozoncp.ocp_offer_api.v1.OfferStatus
+	(ConflictPolicy)(0),                    // 1: ozoncp.ocp_offer_api.v1.ConflictPolicy
+	(CreateOfferResult)(0),                 // 2: ozoncp.ocp_offer_api.v1.CreateOfferResult
+	(OfferStatsDimension)(0),               // 3: ozoncp.ocp_offer_api.v1.OfferStatsDimension
+	(OfferHistoryAction)(0),                // 4: ozoncp.ocp_offer_api.v1.OfferHistoryAction
+	(TaskType)(0),                          // 5: ozoncp.ocp_offer_api.v1.TaskType
+	(TaskStatus)(0),                        // 6: ozoncp.ocp_offer_api.v1.TaskStatus
+	(*Offer)(nil),                          // 7: ozoncp.ocp_offer_api.v1.Offer
+	(*CreateOfferV1Request)(nil),           // 8: ozoncp.ocp_offer_api.v1.CreateOfferV1Request
+	(*CreateOfferV1Response)(nil),          // 9: ozoncp.ocp_offer_api.v1.CreateOfferV1Response
+	(*TaskCreateOfferV1Request)(nil),       // 10: ozoncp.ocp_offer_api.v1.TaskCreateOfferV1Request
+	(*TaskCreateOfferV1Response)(nil),      // 11: ozoncp.ocp_offer_api.v1.TaskCreateOfferV1Response
+	(*MultiCreateOfferV1Request)(nil),      // 12: ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Request
+	(*MultiCreateOfferV1Response)(nil),     // 13: ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Response
+	(*OfferError)(nil),                     // 14: ozoncp.ocp_offer_api.v1.OfferError
+	(*TaskMultiCreateOfferV1Request)(nil),  // 15: ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Request
+	(*TaskMultiCreateOfferV1Response)(nil), // 16: ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Response
+	(*BatchGetOffersV1Request)(nil),        // 17: ozoncp.ocp_offer_api.v1.BatchGetOffersV1Request
+	(*BatchGetOffersV1Response)(nil),       // 18: ozoncp.ocp_offer_api.v1.BatchGetOffersV1Response
+	(*DescribeOfferV1Request)(nil),         // 19: ozoncp.ocp_offer_api.v1.DescribeOfferV1Request
+	(*DescribeOfferV1Response)(nil),        // 20: ozoncp.ocp_offer_api.v1.DescribeOfferV1Response
+	(*GetOfferStatsV1Request)(nil),         // 21: ozoncp.ocp_offer_api.v1.GetOfferStatsV1Request
+	(*OfferStatsGroup)(nil),                // 22: ozoncp.ocp_offer_api.v1.OfferStatsGroup
+	(*GetOfferStatsV1Response)(nil),        // 23: ozoncp.ocp_offer_api.v1.GetOfferStatsV1Response
+	(*GetOfferHistoryV1Request)(nil),       // 24: ozoncp.ocp_offer_api.v1.GetOfferHistoryV1Request
+	(*GetOfferHistoryV1Response)(nil),      // 25: ozoncp.ocp_offer_api.v1.GetOfferHistoryV1Response
+	(*OfferFieldChange)(nil),               // 26: ozoncp.ocp_offer_api.v1.OfferFieldChange
+	(*OfferRevision)(nil),                  // 27: ozoncp.ocp_offer_api.v1.OfferRevision
+	(*ListOfferV1Request)(nil),             // 28: ozoncp.ocp_offer_api.v1.ListOfferV1Request
+	(*ExportOffersV1Request)(nil),          // 29: ozoncp.ocp_offer_api.v1.ExportOffersV1Request
+	(*ExportOffersV1Response)(nil),         // 30: ozoncp.ocp_offer_api.v1.ExportOffersV1Response
+	(*WatchOffersV1Request)(nil),           // 31: ozoncp.ocp_offer_api.v1.WatchOffersV1Request
+	(*WatchOffersV1Response)(nil),          // 32: ozoncp.ocp_offer_api.v1.WatchOffersV1Response
+	(*OfferFilter)(nil),                    // 33: ozoncp.ocp_offer_api.v1.OfferFilter
+	(*ListOfferV1Response)(nil),            // 34: ozoncp.ocp_offer_api.v1.ListOfferV1Response
+	(*UpdateOfferV1Request)(nil),           // 35: ozoncp.ocp_offer_api.v1.UpdateOfferV1Request
+	(*UpdateOfferV1Response)(nil),          // 36: ozoncp.ocp_offer_api.v1.UpdateOfferV1Response
+	(*PatchOfferV1Request)(nil),            // 37: ozoncp.ocp_offer_api.v1.PatchOfferV1Request
+	(*PatchOfferV1Response)(nil),           // 38: ozoncp.ocp_offer_api.v1.PatchOfferV1Response
+	(*SendOfferV1Request)(nil),             // 39: ozoncp.ocp_offer_api.v1.SendOfferV1Request
+	(*SendOfferV1Response)(nil),            // 40: ozoncp.ocp_offer_api.v1.SendOfferV1Response
+	(*AcceptOfferV1Request)(nil),           // 41: ozoncp.ocp_offer_api.v1.AcceptOfferV1Request
+	(*AcceptOfferV1Response)(nil),          // 42: ozoncp.ocp_offer_api.v1.AcceptOfferV1Response
+	(*DeclineOfferV1Request)(nil),          // 43: ozoncp.ocp_offer_api.v1.DeclineOfferV1Request
+	(*DeclineOfferV1Response)(nil),         // 44: ozoncp.ocp_offer_api.v1.DeclineOfferV1Response
+	(*WithdrawOfferV1Request)(nil),         // 45: ozoncp.ocp_offer_api.v1.WithdrawOfferV1Request
+	(*WithdrawOfferV1Response)(nil),        // 46: ozoncp.ocp_offer_api.v1.WithdrawOfferV1Response
+	(*TaskUpdateOfferV1Request)(nil),       // 47: ozoncp.ocp_offer_api.v1.TaskUpdateOfferV1Request
+	(*TaskUpdateOfferV1Response)(nil),      // 48: ozoncp.ocp_offer_api.v1.TaskUpdateOfferV1Response
+	(*RemoveOfferV1Request)(nil),           // 49: ozoncp.ocp_offer_api.v1.RemoveOfferV1Request
+	(*RemoveOfferV1Response)(nil),          // 50: ozoncp.ocp_offer_api.v1.RemoveOfferV1Response
+	(*RestoreOfferV1Request)(nil),          // 51: ozoncp.ocp_offer_api.v1.RestoreOfferV1Request
+	(*RestoreOfferV1Response)(nil),         // 52: ozoncp.ocp_offer_api.v1.RestoreOfferV1Response
+	(*PurgeOffersV1Request)(nil),           // 53: ozoncp.ocp_offer_api.v1.PurgeOffersV1Request
+	(*PurgeOffersV1Response)(nil),          // 54: ozoncp.ocp_offer_api.v1.PurgeOffersV1Response
+	(*TaskRemoveOfferV1Request)(nil),       // 55: ozoncp.ocp_offer_api.v1.TaskRemoveOfferV1Request
+	(*TaskRemoveOfferV1Response)(nil),      // 56: ozoncp.ocp_offer_api.v1.TaskRemoveOfferV1Response
+	(*Task)(nil),                           // 57: ozoncp.ocp_offer_api.v1.Task
+	(*GetTaskStatusV1Request)(nil),         // 58: ozoncp.ocp_offer_api.v1.GetTaskStatusV1Request
+	(*GetTaskStatusV1Response)(nil),        // 59: ozoncp.ocp_offer_api.v1.GetTaskStatusV1Response
+	(*PaginationInfo)(nil),                 // 60: ozoncp.ocp_offer_api.v1.PaginationInfo
+	(*PaginationInput)(nil),                // 61: ozoncp.ocp_offer_api.v1.PaginationInput
+	(*timestamppb.Timestamp)(nil),          // 62: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 63: google.protobuf.FieldMask
 }
 var file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_depIdxs = []int32{
-	1,  // 0: ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Request.offers:type_name -> ozoncp.ocp_offer_api.v1.CreateOfferV1Request
-	1,  // 1: ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Request.offers:type_name -> ozoncp.ocp_offer_api.v1.CreateOfferV1Request
-	0,  // 2: ozoncp.ocp_offer_api.v1.DescribeOfferV1Response.offer:type_name -> ozoncp.ocp_offer_api.v1.Offer
-	22, // 3: ozoncp.ocp_offer_api.v1.ListOfferV1Request.pagination:type_name -> ozoncp.ocp_offer_api.v1.PaginationInput
-	21, // 4: ozoncp.ocp_offer_api.v1.ListOfferV1Response.pagination:type_name -> ozoncp.ocp_offer_api.v1.PaginationInfo
-	0,  // 5: ozoncp.ocp_offer_api.v1.ListOfferV1Response.offers:type_name -> ozoncp.ocp_offer_api.v1.Offer
-	1,  // 6: ozoncp.ocp_offer_api.v1.OcpOfferApiService.CreateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.CreateOfferV1Request
-	3,  // 7: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskCreateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.TaskCreateOfferV1Request
-	5,  // 8: ozoncp.ocp_offer_api.v1.OcpOfferApiService.MultiCreateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Request
-	7,  // 9: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskMultiCreateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Request
-	9,  // 10: ozoncp.ocp_offer_api.v1.OcpOfferApiService.DescribeOfferV1:input_type -> ozoncp.ocp_offer_api.v1.DescribeOfferV1Request
-	11, // 11: ozoncp.ocp_offer_api.v1.OcpOfferApiService.ListOfferV1:input_type -> ozoncp.ocp_offer_api.v1.ListOfferV1Request
-	13, // 12: ozoncp.ocp_offer_api.v1.OcpOfferApiService.UpdateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.UpdateOfferV1Request
-	15, // 13: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskUpdateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.TaskUpdateOfferV1Request
-	17, // 14: ozoncp.ocp_offer_api.v1.OcpOfferApiService.RemoveOfferV1:input_type -> ozoncp.ocp_offer_api.v1.RemoveOfferV1Request
-	19, // 15: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskRemoveOfferV1:input_type -> ozoncp.ocp_offer_api.v1.TaskRemoveOfferV1Request
-	2,  // 16: ozoncp.ocp_offer_api.v1.OcpOfferApiService.CreateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.CreateOfferV1Response
-	4,  // 17: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskCreateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.TaskCreateOfferV1Response
-	6,  // 18: ozoncp.ocp_offer_api.v1.OcpOfferApiService.MultiCreateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Response
-	8,  // 19: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskMultiCreateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Response
-	10, // 20: ozoncp.ocp_offer_api.v1.OcpOfferApiService.DescribeOfferV1:output_type -> ozoncp.ocp_offer_api.v1.DescribeOfferV1Response
-	12, // 21: ozoncp.ocp_offer_api.v1.OcpOfferApiService.ListOfferV1:output_type -> ozoncp.ocp_offer_api.v1.ListOfferV1Response
-	14, // 22: ozoncp.ocp_offer_api.v1.OcpOfferApiService.UpdateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.UpdateOfferV1Response
-	16, // 23: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskUpdateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.TaskUpdateOfferV1Response
-	18, // 24: ozoncp.ocp_offer_api.v1.OcpOfferApiService.RemoveOfferV1:output_type -> ozoncp.ocp_offer_api.v1.RemoveOfferV1Response
-	20, // 25: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskRemoveOfferV1:output_type -> ozoncp.ocp_offer_api.v1.TaskRemoveOfferV1Response
-	16, // [16:26] is the sub-list for method output_type
-	6,  // [6:16] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 0: ozoncp.ocp_offer_api.v1.Offer.status:type_name -> ozoncp.ocp_offer_api.v1.OfferStatus
+	62, // 1: ozoncp.ocp_offer_api.v1.Offer.expires_at:type_name -> google.protobuf.Timestamp
+	62, // 2: ozoncp.ocp_offer_api.v1.Offer.created_at:type_name -> google.protobuf.Timestamp
+	62, // 3: ozoncp.ocp_offer_api.v1.Offer.updated_at:type_name -> google.protobuf.Timestamp
+	62, // 4: ozoncp.ocp_offer_api.v1.CreateOfferV1Request.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: ozoncp.ocp_offer_api.v1.CreateOfferV1Request.conflict_policy:type_name -> ozoncp.ocp_offer_api.v1.ConflictPolicy
+	2,  // 6: ozoncp.ocp_offer_api.v1.CreateOfferV1Response.result:type_name -> ozoncp.ocp_offer_api.v1.CreateOfferResult
+	8,  // 7: ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Request.offers:type_name -> ozoncp.ocp_offer_api.v1.CreateOfferV1Request
+	1,  // 8: ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Request.conflict_policy:type_name -> ozoncp.ocp_offer_api.v1.ConflictPolicy
+	14, // 9: ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Response.errors:type_name -> ozoncp.ocp_offer_api.v1.OfferError
+	2,  // 10: ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Response.results:type_name -> ozoncp.ocp_offer_api.v1.CreateOfferResult
+	8,  // 11: ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Request.offers:type_name -> ozoncp.ocp_offer_api.v1.CreateOfferV1Request
+	7,  // 12: ozoncp.ocp_offer_api.v1.BatchGetOffersV1Response.offers:type_name -> ozoncp.ocp_offer_api.v1.Offer
+	7,  // 13: ozoncp.ocp_offer_api.v1.DescribeOfferV1Response.offer:type_name -> ozoncp.ocp_offer_api.v1.Offer
+	3,  // 14: ozoncp.ocp_offer_api.v1.GetOfferStatsV1Request.group_by:type_name -> ozoncp.ocp_offer_api.v1.OfferStatsDimension
+	62, // 15: ozoncp.ocp_offer_api.v1.GetOfferStatsV1Request.created_from:type_name -> google.protobuf.Timestamp
+	62, // 16: ozoncp.ocp_offer_api.v1.GetOfferStatsV1Request.created_to:type_name -> google.protobuf.Timestamp
+	0,  // 17: ozoncp.ocp_offer_api.v1.OfferStatsGroup.status:type_name -> ozoncp.ocp_offer_api.v1.OfferStatus
+	22, // 18: ozoncp.ocp_offer_api.v1.GetOfferStatsV1Response.groups:type_name -> ozoncp.ocp_offer_api.v1.OfferStatsGroup
+	27, // 19: ozoncp.ocp_offer_api.v1.GetOfferHistoryV1Response.revisions:type_name -> ozoncp.ocp_offer_api.v1.OfferRevision
+	4,  // 20: ozoncp.ocp_offer_api.v1.OfferRevision.action:type_name -> ozoncp.ocp_offer_api.v1.OfferHistoryAction
+	62, // 21: ozoncp.ocp_offer_api.v1.OfferRevision.changed_at:type_name -> google.protobuf.Timestamp
+	26, // 22: ozoncp.ocp_offer_api.v1.OfferRevision.changes:type_name -> ozoncp.ocp_offer_api.v1.OfferFieldChange
+	61, // 23: ozoncp.ocp_offer_api.v1.ListOfferV1Request.pagination:type_name -> ozoncp.ocp_offer_api.v1.PaginationInput
+	33, // 24: ozoncp.ocp_offer_api.v1.ListOfferV1Request.filter:type_name -> ozoncp.ocp_offer_api.v1.OfferFilter
+	33, // 25: ozoncp.ocp_offer_api.v1.ExportOffersV1Request.filter:type_name -> ozoncp.ocp_offer_api.v1.OfferFilter
+	7,  // 26: ozoncp.ocp_offer_api.v1.ExportOffersV1Response.offer:type_name -> ozoncp.ocp_offer_api.v1.Offer
+	27, // 27: ozoncp.ocp_offer_api.v1.WatchOffersV1Response.revision:type_name -> ozoncp.ocp_offer_api.v1.OfferRevision
+	7,  // 28: ozoncp.ocp_offer_api.v1.WatchOffersV1Response.offer:type_name -> ozoncp.ocp_offer_api.v1.Offer
+	60, // 29: ozoncp.ocp_offer_api.v1.ListOfferV1Response.pagination:type_name -> ozoncp.ocp_offer_api.v1.PaginationInfo
+	7,  // 30: ozoncp.ocp_offer_api.v1.ListOfferV1Response.offers:type_name -> ozoncp.ocp_offer_api.v1.Offer
+	62, // 31: ozoncp.ocp_offer_api.v1.UpdateOfferV1Request.expires_at:type_name -> google.protobuf.Timestamp
+	7,  // 32: ozoncp.ocp_offer_api.v1.PatchOfferV1Request.offer:type_name -> ozoncp.ocp_offer_api.v1.Offer
+	63, // 33: ozoncp.ocp_offer_api.v1.PatchOfferV1Request.update_mask:type_name -> google.protobuf.FieldMask
+	62, // 34: ozoncp.ocp_offer_api.v1.PurgeOffersV1Request.deleted_before:type_name -> google.protobuf.Timestamp
+	5,  // 35: ozoncp.ocp_offer_api.v1.Task.type:type_name -> ozoncp.ocp_offer_api.v1.TaskType
+	6,  // 36: ozoncp.ocp_offer_api.v1.Task.status:type_name -> ozoncp.ocp_offer_api.v1.TaskStatus
+	62, // 37: ozoncp.ocp_offer_api.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	62, // 38: ozoncp.ocp_offer_api.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	57, // 39: ozoncp.ocp_offer_api.v1.GetTaskStatusV1Response.task:type_name -> ozoncp.ocp_offer_api.v1.Task
+	8,  // 40: ozoncp.ocp_offer_api.v1.OcpOfferApiService.CreateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.CreateOfferV1Request
+	10, // 41: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskCreateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.TaskCreateOfferV1Request
+	12, // 42: ozoncp.ocp_offer_api.v1.OcpOfferApiService.MultiCreateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Request
+	15, // 43: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskMultiCreateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Request
+	19, // 44: ozoncp.ocp_offer_api.v1.OcpOfferApiService.DescribeOfferV1:input_type -> ozoncp.ocp_offer_api.v1.DescribeOfferV1Request
+	17, // 45: ozoncp.ocp_offer_api.v1.OcpOfferApiService.BatchGetOffersV1:input_type -> ozoncp.ocp_offer_api.v1.BatchGetOffersV1Request
+	29, // 46: ozoncp.ocp_offer_api.v1.OcpOfferApiService.ExportOffersV1:input_type -> ozoncp.ocp_offer_api.v1.ExportOffersV1Request
+	31, // 47: ozoncp.ocp_offer_api.v1.OcpOfferApiService.WatchOffersV1:input_type -> ozoncp.ocp_offer_api.v1.WatchOffersV1Request
+	21, // 48: ozoncp.ocp_offer_api.v1.OcpOfferApiService.GetOfferStatsV1:input_type -> ozoncp.ocp_offer_api.v1.GetOfferStatsV1Request
+	24, // 49: ozoncp.ocp_offer_api.v1.OcpOfferApiService.GetOfferHistoryV1:input_type -> ozoncp.ocp_offer_api.v1.GetOfferHistoryV1Request
+	28, // 50: ozoncp.ocp_offer_api.v1.OcpOfferApiService.ListOfferV1:input_type -> ozoncp.ocp_offer_api.v1.ListOfferV1Request
+	35, // 51: ozoncp.ocp_offer_api.v1.OcpOfferApiService.UpdateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.UpdateOfferV1Request
+	37, // 52: ozoncp.ocp_offer_api.v1.OcpOfferApiService.PatchOfferV1:input_type -> ozoncp.ocp_offer_api.v1.PatchOfferV1Request
+	39, // 53: ozoncp.ocp_offer_api.v1.OcpOfferApiService.SendOfferV1:input_type -> ozoncp.ocp_offer_api.v1.SendOfferV1Request
+	41, // 54: ozoncp.ocp_offer_api.v1.OcpOfferApiService.AcceptOfferV1:input_type -> ozoncp.ocp_offer_api.v1.AcceptOfferV1Request
+	43, // 55: ozoncp.ocp_offer_api.v1.OcpOfferApiService.DeclineOfferV1:input_type -> ozoncp.ocp_offer_api.v1.DeclineOfferV1Request
+	45, // 56: ozoncp.ocp_offer_api.v1.OcpOfferApiService.WithdrawOfferV1:input_type -> ozoncp.ocp_offer_api.v1.WithdrawOfferV1Request
+	47, // 57: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskUpdateOfferV1:input_type -> ozoncp.ocp_offer_api.v1.TaskUpdateOfferV1Request
+	49, // 58: ozoncp.ocp_offer_api.v1.OcpOfferApiService.RemoveOfferV1:input_type -> ozoncp.ocp_offer_api.v1.RemoveOfferV1Request
+	51, // 59: ozoncp.ocp_offer_api.v1.OcpOfferApiService.RestoreOfferV1:input_type -> ozoncp.ocp_offer_api.v1.RestoreOfferV1Request
+	53, // 60: ozoncp.ocp_offer_api.v1.OcpOfferApiService.PurgeOffersV1:input_type -> ozoncp.ocp_offer_api.v1.PurgeOffersV1Request
+	55, // 61: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskRemoveOfferV1:input_type -> ozoncp.ocp_offer_api.v1.TaskRemoveOfferV1Request
+	58, // 62: ozoncp.ocp_offer_api.v1.OcpOfferApiService.GetTaskStatusV1:input_type -> ozoncp.ocp_offer_api.v1.GetTaskStatusV1Request
+	9,  // 63: ozoncp.ocp_offer_api.v1.OcpOfferApiService.CreateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.CreateOfferV1Response
+	11, // 64: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskCreateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.TaskCreateOfferV1Response
+	13, // 65: ozoncp.ocp_offer_api.v1.OcpOfferApiService.MultiCreateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.MultiCreateOfferV1Response
+	16, // 66: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskMultiCreateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.TaskMultiCreateOfferV1Response
+	20, // 67: ozoncp.ocp_offer_api.v1.OcpOfferApiService.DescribeOfferV1:output_type -> ozoncp.ocp_offer_api.v1.DescribeOfferV1Response
+	18, // 68: ozoncp.ocp_offer_api.v1.OcpOfferApiService.BatchGetOffersV1:output_type -> ozoncp.ocp_offer_api.v1.BatchGetOffersV1Response
+	30, // 69: ozoncp.ocp_offer_api.v1.OcpOfferApiService.ExportOffersV1:output_type -> ozoncp.ocp_offer_api.v1.ExportOffersV1Response
+	32, // 70: ozoncp.ocp_offer_api.v1.OcpOfferApiService.WatchOffersV1:output_type -> ozoncp.ocp_offer_api.v1.WatchOffersV1Response
+	23, // 71: ozoncp.ocp_offer_api.v1.OcpOfferApiService.GetOfferStatsV1:output_type -> ozoncp.ocp_offer_api.v1.GetOfferStatsV1Response
+	25, // 72: ozoncp.ocp_offer_api.v1.OcpOfferApiService.GetOfferHistoryV1:output_type -> ozoncp.ocp_offer_api.v1.GetOfferHistoryV1Response
+	34, // 73: ozoncp.ocp_offer_api.v1.OcpOfferApiService.ListOfferV1:output_type -> ozoncp.ocp_offer_api.v1.ListOfferV1Response
+	36, // 74: ozoncp.ocp_offer_api.v1.OcpOfferApiService.UpdateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.UpdateOfferV1Response
+	38, // 75: ozoncp.ocp_offer_api.v1.OcpOfferApiService.PatchOfferV1:output_type -> ozoncp.ocp_offer_api.v1.PatchOfferV1Response
+	40, // 76: ozoncp.ocp_offer_api.v1.OcpOfferApiService.SendOfferV1:output_type -> ozoncp.ocp_offer_api.v1.SendOfferV1Response
+	42, // 77: ozoncp.ocp_offer_api.v1.OcpOfferApiService.AcceptOfferV1:output_type -> ozoncp.ocp_offer_api.v1.AcceptOfferV1Response
+	44, // 78: ozoncp.ocp_offer_api.v1.OcpOfferApiService.DeclineOfferV1:output_type -> ozoncp.ocp_offer_api.v1.DeclineOfferV1Response
+	46, // 79: ozoncp.ocp_offer_api.v1.OcpOfferApiService.WithdrawOfferV1:output_type -> ozoncp.ocp_offer_api.v1.WithdrawOfferV1Response
+	48, // 80: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskUpdateOfferV1:output_type -> ozoncp.ocp_offer_api.v1.TaskUpdateOfferV1Response
+	50, // 81: ozoncp.ocp_offer_api.v1.OcpOfferApiService.RemoveOfferV1:output_type -> ozoncp.ocp_offer_api.v1.RemoveOfferV1Response
+	52, // 82: ozoncp.ocp_offer_api.v1.OcpOfferApiService.RestoreOfferV1:output_type -> ozoncp.ocp_offer_api.v1.RestoreOfferV1Response
+	54, // 83: ozoncp.ocp_offer_api.v1.OcpOfferApiService.PurgeOffersV1:output_type -> ozoncp.ocp_offer_api.v1.PurgeOffersV1Response
+	56, // 84: ozoncp.ocp_offer_api.v1.OcpOfferApiService.TaskRemoveOfferV1:output_type -> ozoncp.ocp_offer_api.v1.TaskRemoveOfferV1Response
+	59, // 85: ozoncp.ocp_offer_api.v1.OcpOfferApiService.GetTaskStatusV1:output_type -> ozoncp.ocp_offer_api.v1.GetTaskStatusV1Response
+	63, // [63:86] is the sub-list for method output_type
+	40, // [40:63] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() }
@@ -1588,8 +4624,152 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOfferV1Request); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskCreateOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskCreateOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiCreateOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiCreateOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskMultiCreateOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskMultiCreateOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetOffersV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetOffersV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeOfferV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1600,8 +4780,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOfferV1Response); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfferStatsV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1612,8 +4792,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskCreateOfferV1Request); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferStatsGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1624,8 +4804,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskCreateOfferV1Response); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfferStatsV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1636,8 +4816,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiCreateOfferV1Request); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfferHistoryV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1648,8 +4828,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiCreateOfferV1Response); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfferHistoryV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1660,8 +4840,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskMultiCreateOfferV1Request); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferFieldChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1672,8 +4852,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskMultiCreateOfferV1Response); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1684,8 +4864,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeOfferV1Request); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOfferV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1696,8 +4876,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeOfferV1Response); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportOffersV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1708,8 +4888,8 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOfferV1Request); i {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportOffersV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1720,7 +4900,43 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOffersV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOffersV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListOfferV1Response); i {
 			case 0:
 				return &v.state
@@ -1732,7 +4948,7 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateOfferV1Request); i {
 			case 0:
 				return &v.state
@@ -1744,7 +4960,7 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateOfferV1Response); i {
 			case 0:
 				return &v.state
@@ -1756,7 +4972,127 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskUpdateOfferV1Request); i {
 			case 0:
 				return &v.state
@@ -1768,7 +5104,7 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskUpdateOfferV1Response); i {
 			case 0:
 				return &v.state
@@ -1780,7 +5116,7 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveOfferV1Request); i {
 			case 0:
 				return &v.state
@@ -1792,7 +5128,7 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveOfferV1Response); i {
 			case 0:
 				return &v.state
@@ -1804,7 +5140,55 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreOfferV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreOfferV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeOffersV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeOffersV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskRemoveOfferV1Request); i {
 			case 0:
 				return &v.state
@@ -1816,7 +5200,7 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskRemoveOfferV1Response); i {
 			case 0:
 				return &v.state
@@ -1828,7 +5212,43 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Task); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaskStatusV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaskStatusV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaginationInfo); i {
 			case 0:
 				return &v.state
@@ -1840,7 +5260,7 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 				return nil
 			}
 		}
-		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaginationInput); i {
 			case 0:
 				return &v.state
@@ -1858,13 +5278,14 @@ func file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   23,
+			NumEnums:      7,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_goTypes,
 		DependencyIndexes: file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_depIdxs,
+		EnumInfos:         file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_enumTypes,
 		MessageInfos:      file_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto_msgTypes,
 	}.Build()
 	File_ozoncp_ocp_offer_api_v1_ocp_offer_api_proto = out.File
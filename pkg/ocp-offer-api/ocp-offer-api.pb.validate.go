@@ -33,6 +33,9 @@ var (
 	_ = anypb.Any{}
 )
 
+// define the regex for a UUID once up-front
+var _ocp_offer_api_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on Offer with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
 func (m *Offer) Validate() error {
@@ -48,6 +51,40 @@ func (m *Offer) Validate() error {
 
 	// no validation rules for TeamId
 
+	// no validation rules for Status
+
+	if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OfferValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OfferValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OfferValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -134,6 +171,34 @@ func (m *CreateOfferV1Request) Validate() error {
 		}
 	}
 
+	if t := m.GetExpiresAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			return CreateOfferV1RequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+		}
+
+		now := time.Now()
+
+		if ts.Sub(now) <= 0 {
+			return CreateOfferV1RequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value must be greater than now",
+			}
+		}
+
+	}
+
+	if _, ok := ConflictPolicy_name[int32(m.GetConflictPolicy())]; !ok {
+		return CreateOfferV1RequestValidationError{
+			field:  "ConflictPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
 	return nil
 }
 
@@ -203,6 +268,8 @@ func (m *CreateOfferV1Response) Validate() error {
 
 	// no validation rules for Id
 
+	// no validation rules for Result
+
 	return nil
 }
 
@@ -358,6 +425,8 @@ func (m *TaskCreateOfferV1Response) Validate() error {
 		return nil
 	}
 
+	// no validation rules for TaskId
+
 	return nil
 }
 
@@ -440,6 +509,15 @@ func (m *MultiCreateOfferV1Request) Validate() error {
 
 	}
 
+	// no validation rules for BestEffort
+
+	if _, ok := ConflictPolicy_name[int32(m.GetConflictPolicy())]; !ok {
+		return MultiCreateOfferV1RequestValidationError{
+			field:  "ConflictPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
 	return nil
 }
 
@@ -509,6 +587,21 @@ func (m *MultiCreateOfferV1Response) Validate() error {
 
 	// no validation rules for Count
 
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiCreateOfferV1ResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -568,6 +661,76 @@ var _ interface {
 	ErrorName() string
 } = MultiCreateOfferV1ResponseValidationError{}
 
+// Validate checks the field values on OfferError with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *OfferError) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Index
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	return nil
+}
+
+// OfferErrorValidationError is the validation error returned by
+// OfferError.Validate if the designated constraints aren't met.
+type OfferErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OfferErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OfferErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OfferErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OfferErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OfferErrorValidationError) ErrorName() string { return "OfferErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OfferErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOfferError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OfferErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OfferErrorValidationError{}
+
 // Validate checks the field values on TaskMultiCreateOfferV1Request with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -666,6 +829,8 @@ func (m *TaskMultiCreateOfferV1Response) Validate() error {
 		return nil
 	}
 
+	// no validation rules for TaskId
+
 	return nil
 }
 
@@ -726,27 +891,50 @@ var _ interface {
 	ErrorName() string
 } = TaskMultiCreateOfferV1ResponseValidationError{}
 
-// Validate checks the field values on DescribeOfferV1Request with the rules
+// Validate checks the field values on BatchGetOffersV1Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DescribeOfferV1Request) Validate() error {
+func (m *BatchGetOffersV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetId() <= 0 {
-		return DescribeOfferV1RequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
+	if l := len(m.GetIds()); l < 1 || l > 1000 {
+		return BatchGetOffersV1RequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+	}
+
+	_BatchGetOffersV1Request_Ids_Unique := make(map[uint64]struct{}, len(m.GetIds()))
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if _, exists := _BatchGetOffersV1Request_Ids_Unique[item]; exists {
+			return BatchGetOffersV1RequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_BatchGetOffersV1Request_Ids_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			return BatchGetOffersV1RequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
 		}
+
 	}
 
 	return nil
 }
 
-// DescribeOfferV1RequestValidationError is the validation error returned by
-// DescribeOfferV1Request.Validate if the designated constraints aren't met.
-type DescribeOfferV1RequestValidationError struct {
+// BatchGetOffersV1RequestValidationError is the validation error returned by
+// BatchGetOffersV1Request.Validate if the designated constraints aren't met.
+type BatchGetOffersV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -754,24 +942,24 @@ type DescribeOfferV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeOfferV1RequestValidationError) Field() string { return e.field }
+func (e BatchGetOffersV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeOfferV1RequestValidationError) Reason() string { return e.reason }
+func (e BatchGetOffersV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeOfferV1RequestValidationError) Cause() error { return e.cause }
+func (e BatchGetOffersV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeOfferV1RequestValidationError) Key() bool { return e.key }
+func (e BatchGetOffersV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeOfferV1RequestValidationError) ErrorName() string {
-	return "DescribeOfferV1RequestValidationError"
+func (e BatchGetOffersV1RequestValidationError) ErrorName() string {
+	return "BatchGetOffersV1RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeOfferV1RequestValidationError) Error() string {
+func (e BatchGetOffersV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -783,14 +971,14 @@ func (e DescribeOfferV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeOfferV1Request.%s: %s%s",
+		"invalid %sBatchGetOffersV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeOfferV1RequestValidationError{}
+var _ error = BatchGetOffersV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -798,32 +986,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeOfferV1RequestValidationError{}
+} = BatchGetOffersV1RequestValidationError{}
 
-// Validate checks the field values on DescribeOfferV1Response with the rules
+// Validate checks the field values on BatchGetOffersV1Response with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DescribeOfferV1Response) Validate() error {
+func (m *BatchGetOffersV1Response) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetOffer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DescribeOfferV1ResponseValidationError{
-				field:  "Offer",
-				reason: "embedded message failed validation",
-				cause:  err,
+	for idx, item := range m.GetOffers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetOffersV1ResponseValidationError{
+					field:  fmt.Sprintf("Offers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	return nil
 }
 
-// DescribeOfferV1ResponseValidationError is the validation error returned by
-// DescribeOfferV1Response.Validate if the designated constraints aren't met.
-type DescribeOfferV1ResponseValidationError struct {
+// BatchGetOffersV1ResponseValidationError is the validation error returned by
+// BatchGetOffersV1Response.Validate if the designated constraints aren't met.
+type BatchGetOffersV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -831,24 +1024,24 @@ type DescribeOfferV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeOfferV1ResponseValidationError) Field() string { return e.field }
+func (e BatchGetOffersV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeOfferV1ResponseValidationError) Reason() string { return e.reason }
+func (e BatchGetOffersV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeOfferV1ResponseValidationError) Cause() error { return e.cause }
+func (e BatchGetOffersV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeOfferV1ResponseValidationError) Key() bool { return e.key }
+func (e BatchGetOffersV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeOfferV1ResponseValidationError) ErrorName() string {
-	return "DescribeOfferV1ResponseValidationError"
+func (e BatchGetOffersV1ResponseValidationError) ErrorName() string {
+	return "BatchGetOffersV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeOfferV1ResponseValidationError) Error() string {
+func (e BatchGetOffersV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -860,14 +1053,14 @@ func (e DescribeOfferV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeOfferV1Response.%s: %s%s",
+		"invalid %sBatchGetOffersV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeOfferV1ResponseValidationError{}
+var _ error = BatchGetOffersV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -875,39 +1068,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeOfferV1ResponseValidationError{}
+} = BatchGetOffersV1ResponseValidationError{}
 
-// Validate checks the field values on ListOfferV1Request with the rules
+// Validate checks the field values on DescribeOfferV1Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListOfferV1Request) Validate() error {
+func (m *DescribeOfferV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetPagination() == nil {
-		return ListOfferV1RequestValidationError{
-			field:  "Pagination",
-			reason: "value is required",
-		}
-	}
-
-	if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListOfferV1RequestValidationError{
-				field:  "Pagination",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	if m.GetId() <= 0 {
+		return DescribeOfferV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
 		}
 	}
 
 	return nil
 }
 
-// ListOfferV1RequestValidationError is the validation error returned by
-// ListOfferV1Request.Validate if the designated constraints aren't met.
-type ListOfferV1RequestValidationError struct {
+// DescribeOfferV1RequestValidationError is the validation error returned by
+// DescribeOfferV1Request.Validate if the designated constraints aren't met.
+type DescribeOfferV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -915,24 +1098,24 @@ type ListOfferV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOfferV1RequestValidationError) Field() string { return e.field }
+func (e DescribeOfferV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOfferV1RequestValidationError) Reason() string { return e.reason }
+func (e DescribeOfferV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOfferV1RequestValidationError) Cause() error { return e.cause }
+func (e DescribeOfferV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOfferV1RequestValidationError) Key() bool { return e.key }
+func (e DescribeOfferV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOfferV1RequestValidationError) ErrorName() string {
-	return "ListOfferV1RequestValidationError"
+func (e DescribeOfferV1RequestValidationError) ErrorName() string {
+	return "DescribeOfferV1RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOfferV1RequestValidationError) Error() string {
+func (e DescribeOfferV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -944,14 +1127,14 @@ func (e ListOfferV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOfferV1Request.%s: %s%s",
+		"invalid %sDescribeOfferV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOfferV1RequestValidationError{}
+var _ error = DescribeOfferV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -959,47 +1142,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOfferV1RequestValidationError{}
+} = DescribeOfferV1RequestValidationError{}
 
-// Validate checks the field values on ListOfferV1Response with the rules
+// Validate checks the field values on DescribeOfferV1Response with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListOfferV1Response) Validate() error {
+func (m *DescribeOfferV1Response) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+	if v, ok := interface{}(m.GetOffer()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListOfferV1ResponseValidationError{
-				field:  "Pagination",
+			return DescribeOfferV1ResponseValidationError{
+				field:  "Offer",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetOffers() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListOfferV1ResponseValidationError{
-					field:  fmt.Sprintf("Offers[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	return nil
 }
 
-// ListOfferV1ResponseValidationError is the validation error returned by
-// ListOfferV1Response.Validate if the designated constraints aren't met.
-type ListOfferV1ResponseValidationError struct {
+// DescribeOfferV1ResponseValidationError is the validation error returned by
+// DescribeOfferV1Response.Validate if the designated constraints aren't met.
+type DescribeOfferV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1007,24 +1175,2378 @@ type ListOfferV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOfferV1ResponseValidationError) Field() string { return e.field }
+func (e DescribeOfferV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOfferV1ResponseValidationError) Reason() string { return e.reason }
+func (e DescribeOfferV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOfferV1ResponseValidationError) Cause() error { return e.cause }
+func (e DescribeOfferV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeOfferV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeOfferV1ResponseValidationError) ErrorName() string {
+	return "DescribeOfferV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeOfferV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeOfferV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeOfferV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeOfferV1ResponseValidationError{}
+
+// Validate checks the field values on GetOfferStatsV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetOfferStatsV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetGroupBy()) < 1 {
+		return GetOfferStatsV1RequestValidationError{
+			field:  "GroupBy",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	_GetOfferStatsV1Request_GroupBy_Unique := make(map[OfferStatsDimension]struct{}, len(m.GetGroupBy()))
+
+	for idx, item := range m.GetGroupBy() {
+		_, _ = idx, item
+
+		if _, exists := _GetOfferStatsV1Request_GroupBy_Unique[item]; exists {
+			return GetOfferStatsV1RequestValidationError{
+				field:  fmt.Sprintf("GroupBy[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_GetOfferStatsV1Request_GroupBy_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _GetOfferStatsV1Request_GroupBy_NotInLookup[item]; ok {
+			return GetOfferStatsV1RequestValidationError{
+				field:  fmt.Sprintf("GroupBy[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+		}
+
+		if _, ok := OfferStatsDimension_name[int32(item)]; !ok {
+			return GetOfferStatsV1RequestValidationError{
+				field:  fmt.Sprintf("GroupBy[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+		}
+
+	}
+
+	// no validation rules for TeamId
+
+	if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOfferStatsV1RequestValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOfferStatsV1RequestValidationError{
+				field:  "CreatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeDeleted
+
+	return nil
+}
+
+// GetOfferStatsV1RequestValidationError is the validation error returned by
+// GetOfferStatsV1Request.Validate if the designated constraints aren't met.
+type GetOfferStatsV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOfferStatsV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOfferStatsV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOfferStatsV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOfferStatsV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOfferStatsV1RequestValidationError) ErrorName() string {
+	return "GetOfferStatsV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOfferStatsV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOfferStatsV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOfferStatsV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOfferStatsV1RequestValidationError{}
+
+var _GetOfferStatsV1Request_GroupBy_NotInLookup = map[OfferStatsDimension]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on OfferStatsGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *OfferStatsGroup) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TeamId
+
+	// no validation rules for Grade
+
+	// no validation rules for Status
+
+	// no validation rules for Count
+
+	return nil
+}
+
+// OfferStatsGroupValidationError is the validation error returned by
+// OfferStatsGroup.Validate if the designated constraints aren't met.
+type OfferStatsGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OfferStatsGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OfferStatsGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OfferStatsGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OfferStatsGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OfferStatsGroupValidationError) ErrorName() string { return "OfferStatsGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OfferStatsGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOfferStatsGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OfferStatsGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OfferStatsGroupValidationError{}
+
+// Validate checks the field values on GetOfferStatsV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetOfferStatsV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOfferStatsV1ResponseValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	return nil
+}
+
+// GetOfferStatsV1ResponseValidationError is the validation error returned by
+// GetOfferStatsV1Response.Validate if the designated constraints aren't met.
+type GetOfferStatsV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOfferStatsV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOfferStatsV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOfferStatsV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOfferStatsV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOfferStatsV1ResponseValidationError) ErrorName() string {
+	return "GetOfferStatsV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOfferStatsV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOfferStatsV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOfferStatsV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOfferStatsV1ResponseValidationError{}
+
+// Validate checks the field values on GetOfferHistoryV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetOfferHistoryV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return GetOfferHistoryV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// GetOfferHistoryV1RequestValidationError is the validation error returned by
+// GetOfferHistoryV1Request.Validate if the designated constraints aren't met.
+type GetOfferHistoryV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOfferHistoryV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOfferHistoryV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOfferHistoryV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOfferHistoryV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOfferHistoryV1RequestValidationError) ErrorName() string {
+	return "GetOfferHistoryV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOfferHistoryV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOfferHistoryV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOfferHistoryV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOfferHistoryV1RequestValidationError{}
+
+// Validate checks the field values on GetOfferHistoryV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetOfferHistoryV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOfferHistoryV1ResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetOfferHistoryV1ResponseValidationError is the validation error returned by
+// GetOfferHistoryV1Response.Validate if the designated constraints aren't met.
+type GetOfferHistoryV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOfferHistoryV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOfferHistoryV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOfferHistoryV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOfferHistoryV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOfferHistoryV1ResponseValidationError) ErrorName() string {
+	return "GetOfferHistoryV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOfferHistoryV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOfferHistoryV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOfferHistoryV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOfferHistoryV1ResponseValidationError{}
+
+// Validate checks the field values on OfferFieldChange with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *OfferFieldChange) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Field
+
+	// no validation rules for OldValue
+
+	// no validation rules for NewValue
+
+	return nil
+}
+
+// OfferFieldChangeValidationError is the validation error returned by
+// OfferFieldChange.Validate if the designated constraints aren't met.
+type OfferFieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OfferFieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OfferFieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OfferFieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OfferFieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OfferFieldChangeValidationError) ErrorName() string { return "OfferFieldChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OfferFieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOfferFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OfferFieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OfferFieldChangeValidationError{}
+
+// Validate checks the field values on OfferRevision with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *OfferRevision) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Revision
+
+	// no validation rules for Action
+
+	// no validation rules for Actor
+
+	if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OfferRevisionValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OfferRevisionValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// OfferRevisionValidationError is the validation error returned by
+// OfferRevision.Validate if the designated constraints aren't met.
+type OfferRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OfferRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OfferRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OfferRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OfferRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OfferRevisionValidationError) ErrorName() string { return "OfferRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OfferRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOfferRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OfferRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OfferRevisionValidationError{}
+
+// Validate checks the field values on ListOfferV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListOfferV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetPagination() == nil {
+		return ListOfferV1RequestValidationError{
+			field:  "Pagination",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOfferV1RequestValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOfferV1RequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetOrderBy()) > 64 {
+		return ListOfferV1RequestValidationError{
+			field:  "OrderBy",
+			reason: "value length must be at most 64 runes",
+		}
+	}
+
+	return nil
+}
+
+// ListOfferV1RequestValidationError is the validation error returned by
+// ListOfferV1Request.Validate if the designated constraints aren't met.
+type ListOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOfferV1RequestValidationError) ErrorName() string {
+	return "ListOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOfferV1RequestValidationError{}
+
+// Validate checks the field values on ExportOffersV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportOffersV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportOffersV1RequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExportOffersV1RequestValidationError is the validation error returned by
+// ExportOffersV1Request.Validate if the designated constraints aren't met.
+type ExportOffersV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportOffersV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportOffersV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportOffersV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportOffersV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportOffersV1RequestValidationError) ErrorName() string {
+	return "ExportOffersV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportOffersV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportOffersV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportOffersV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportOffersV1RequestValidationError{}
+
+// Validate checks the field values on ExportOffersV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportOffersV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetOffer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportOffersV1ResponseValidationError{
+				field:  "Offer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExportOffersV1ResponseValidationError is the validation error returned by
+// ExportOffersV1Response.Validate if the designated constraints aren't met.
+type ExportOffersV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportOffersV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportOffersV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportOffersV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportOffersV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportOffersV1ResponseValidationError) ErrorName() string {
+	return "ExportOffersV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportOffersV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportOffersV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportOffersV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportOffersV1ResponseValidationError{}
+
+// Validate checks the field values on WatchOffersV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WatchOffersV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetResumeToken()) > 1024 {
+		return WatchOffersV1RequestValidationError{
+			field:  "ResumeToken",
+			reason: "value length must be at most 1024 runes",
+		}
+	}
+
+	if len(m.GetUserIds()) > 1000 {
+		return WatchOffersV1RequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			return WatchOffersV1RequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	if len(m.GetTeamIds()) > 1000 {
+		return WatchOffersV1RequestValidationError{
+			field:  "TeamIds",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetTeamIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			return WatchOffersV1RequestValidationError{
+				field:  fmt.Sprintf("TeamIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// WatchOffersV1RequestValidationError is the validation error returned by
+// WatchOffersV1Request.Validate if the designated constraints aren't met.
+type WatchOffersV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchOffersV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchOffersV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchOffersV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchOffersV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchOffersV1RequestValidationError) ErrorName() string {
+	return "WatchOffersV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchOffersV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchOffersV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchOffersV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchOffersV1RequestValidationError{}
+
+// Validate checks the field values on WatchOffersV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WatchOffersV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ResumeToken
+
+	// no validation rules for OfferId
+
+	if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchOffersV1ResponseValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOffer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchOffersV1ResponseValidationError{
+				field:  "Offer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// WatchOffersV1ResponseValidationError is the validation error returned by
+// WatchOffersV1Response.Validate if the designated constraints aren't met.
+type WatchOffersV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchOffersV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchOffersV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchOffersV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchOffersV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchOffersV1ResponseValidationError) ErrorName() string {
+	return "WatchOffersV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchOffersV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchOffersV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchOffersV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchOffersV1ResponseValidationError{}
+
+// Validate checks the field values on OfferFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *OfferFilter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetUserIds()) > 1000 {
+		return OfferFilterValidationError{
+			field:  "UserIds",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			return OfferFilterValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	if len(m.GetTeamIds()) > 1000 {
+		return OfferFilterValidationError{
+			field:  "TeamIds",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetTeamIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			return OfferFilterValidationError{
+				field:  fmt.Sprintf("TeamIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	// no validation rules for GradeMin
+
+	// no validation rules for GradeMax
+
+	// no validation rules for IncludeDeleted
+
+	return nil
+}
+
+// OfferFilterValidationError is the validation error returned by
+// OfferFilter.Validate if the designated constraints aren't met.
+type OfferFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OfferFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OfferFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OfferFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OfferFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OfferFilterValidationError) ErrorName() string { return "OfferFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OfferFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOfferFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OfferFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OfferFilterValidationError{}
+
+// Validate checks the field values on ListOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOfferV1ResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetOffers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOfferV1ResponseValidationError{
+					field:  fmt.Sprintf("Offers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListOfferV1ResponseValidationError is the validation error returned by
+// ListOfferV1Response.Validate if the designated constraints aren't met.
+type ListOfferV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOfferV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOfferV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOfferV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
 func (e ListOfferV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOfferV1ResponseValidationError) ErrorName() string {
-	return "ListOfferV1ResponseValidationError"
+func (e ListOfferV1ResponseValidationError) ErrorName() string {
+	return "ListOfferV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOfferV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOfferV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOfferV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOfferV1ResponseValidationError{}
+
+// Validate checks the field values on UpdateOfferV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateOfferV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return UpdateOfferV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetUserId() <= 0 {
+		return UpdateOfferV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetGrade() <= 0 {
+		return UpdateOfferV1RequestValidationError{
+			field:  "Grade",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetTeamId() <= 0 {
+		return UpdateOfferV1RequestValidationError{
+			field:  "TeamId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if t := m.GetExpiresAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			return UpdateOfferV1RequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+		}
+
+		now := time.Now()
+
+		if ts.Sub(now) <= 0 {
+			return UpdateOfferV1RequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value must be greater than now",
+			}
+		}
+
+	}
+
+	// no validation rules for ExpectedVersion
+
+	return nil
+}
+
+// UpdateOfferV1RequestValidationError is the validation error returned by
+// UpdateOfferV1Request.Validate if the designated constraints aren't met.
+type UpdateOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOfferV1RequestValidationError) ErrorName() string {
+	return "UpdateOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOfferV1RequestValidationError{}
+
+// Validate checks the field values on UpdateOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// UpdateOfferV1ResponseValidationError is the validation error returned by
+// UpdateOfferV1Response.Validate if the designated constraints aren't met.
+type UpdateOfferV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOfferV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOfferV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOfferV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOfferV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOfferV1ResponseValidationError) ErrorName() string {
+	return "UpdateOfferV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOfferV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOfferV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOfferV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOfferV1ResponseValidationError{}
+
+// Validate checks the field values on PatchOfferV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PatchOfferV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return PatchOfferV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetOffer() == nil {
+		return PatchOfferV1RequestValidationError{
+			field:  "Offer",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetOffer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchOfferV1RequestValidationError{
+				field:  "Offer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchOfferV1RequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpectedVersion
+
+	return nil
+}
+
+// PatchOfferV1RequestValidationError is the validation error returned by
+// PatchOfferV1Request.Validate if the designated constraints aren't met.
+type PatchOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchOfferV1RequestValidationError) ErrorName() string {
+	return "PatchOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchOfferV1RequestValidationError{}
+
+// Validate checks the field values on PatchOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PatchOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// PatchOfferV1ResponseValidationError is the validation error returned by
+// PatchOfferV1Response.Validate if the designated constraints aren't met.
+type PatchOfferV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchOfferV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchOfferV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchOfferV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchOfferV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchOfferV1ResponseValidationError) ErrorName() string {
+	return "PatchOfferV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchOfferV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchOfferV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchOfferV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchOfferV1ResponseValidationError{}
+
+// Validate checks the field values on SendOfferV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SendOfferV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return SendOfferV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// SendOfferV1RequestValidationError is the validation error returned by
+// SendOfferV1Request.Validate if the designated constraints aren't met.
+type SendOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendOfferV1RequestValidationError) ErrorName() string {
+	return "SendOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendOfferV1RequestValidationError{}
+
+// Validate checks the field values on SendOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SendOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// SendOfferV1ResponseValidationError is the validation error returned by
+// SendOfferV1Response.Validate if the designated constraints aren't met.
+type SendOfferV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendOfferV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendOfferV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendOfferV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendOfferV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendOfferV1ResponseValidationError) ErrorName() string {
+	return "SendOfferV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendOfferV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendOfferV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendOfferV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendOfferV1ResponseValidationError{}
+
+// Validate checks the field values on AcceptOfferV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AcceptOfferV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return AcceptOfferV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// AcceptOfferV1RequestValidationError is the validation error returned by
+// AcceptOfferV1Request.Validate if the designated constraints aren't met.
+type AcceptOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptOfferV1RequestValidationError) ErrorName() string {
+	return "AcceptOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptOfferV1RequestValidationError{}
+
+// Validate checks the field values on AcceptOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AcceptOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// AcceptOfferV1ResponseValidationError is the validation error returned by
+// AcceptOfferV1Response.Validate if the designated constraints aren't met.
+type AcceptOfferV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptOfferV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptOfferV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptOfferV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptOfferV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptOfferV1ResponseValidationError) ErrorName() string {
+	return "AcceptOfferV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptOfferV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptOfferV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptOfferV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptOfferV1ResponseValidationError{}
+
+// Validate checks the field values on DeclineOfferV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeclineOfferV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return DeclineOfferV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// DeclineOfferV1RequestValidationError is the validation error returned by
+// DeclineOfferV1Request.Validate if the designated constraints aren't met.
+type DeclineOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeclineOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeclineOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeclineOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeclineOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeclineOfferV1RequestValidationError) ErrorName() string {
+	return "DeclineOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeclineOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeclineOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeclineOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeclineOfferV1RequestValidationError{}
+
+// Validate checks the field values on DeclineOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeclineOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeclineOfferV1ResponseValidationError is the validation error returned by
+// DeclineOfferV1Response.Validate if the designated constraints aren't met.
+type DeclineOfferV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeclineOfferV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeclineOfferV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeclineOfferV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeclineOfferV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeclineOfferV1ResponseValidationError) ErrorName() string {
+	return "DeclineOfferV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeclineOfferV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeclineOfferV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeclineOfferV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeclineOfferV1ResponseValidationError{}
+
+// Validate checks the field values on WithdrawOfferV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WithdrawOfferV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return WithdrawOfferV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// WithdrawOfferV1RequestValidationError is the validation error returned by
+// WithdrawOfferV1Request.Validate if the designated constraints aren't met.
+type WithdrawOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawOfferV1RequestValidationError) ErrorName() string {
+	return "WithdrawOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawOfferV1RequestValidationError{}
+
+// Validate checks the field values on WithdrawOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WithdrawOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// WithdrawOfferV1ResponseValidationError is the validation error returned by
+// WithdrawOfferV1Response.Validate if the designated constraints aren't met.
+type WithdrawOfferV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawOfferV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawOfferV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawOfferV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawOfferV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawOfferV1ResponseValidationError) ErrorName() string {
+	return "WithdrawOfferV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawOfferV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawOfferV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawOfferV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawOfferV1ResponseValidationError{}
+
+// Validate checks the field values on TaskUpdateOfferV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *TaskUpdateOfferV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return TaskUpdateOfferV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetUserId() <= 0 {
+		return TaskUpdateOfferV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetGrade() <= 0 {
+		return TaskUpdateOfferV1RequestValidationError{
+			field:  "Grade",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetTeamId() <= 0 {
+		return TaskUpdateOfferV1RequestValidationError{
+			field:  "TeamId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// TaskUpdateOfferV1RequestValidationError is the validation error returned by
+// TaskUpdateOfferV1Request.Validate if the designated constraints aren't met.
+type TaskUpdateOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskUpdateOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskUpdateOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskUpdateOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskUpdateOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskUpdateOfferV1RequestValidationError) ErrorName() string {
+	return "TaskUpdateOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TaskUpdateOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskUpdateOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskUpdateOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskUpdateOfferV1RequestValidationError{}
+
+// Validate checks the field values on TaskUpdateOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *TaskUpdateOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TaskId
+
+	return nil
+}
+
+// TaskUpdateOfferV1ResponseValidationError is the validation error returned by
+// TaskUpdateOfferV1Response.Validate if the designated constraints aren't met.
+type TaskUpdateOfferV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskUpdateOfferV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskUpdateOfferV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskUpdateOfferV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskUpdateOfferV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskUpdateOfferV1ResponseValidationError) ErrorName() string {
+	return "TaskUpdateOfferV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOfferV1ResponseValidationError) Error() string {
+func (e TaskUpdateOfferV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1036,14 +3558,14 @@ func (e ListOfferV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOfferV1Response.%s: %s%s",
+		"invalid %sTaskUpdateOfferV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOfferV1ResponseValidationError{}
+var _ error = TaskUpdateOfferV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1051,40 +3573,162 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOfferV1ResponseValidationError{}
+} = TaskUpdateOfferV1ResponseValidationError{}
 
-// Validate checks the field values on UpdateOfferV1Request with the rules
+// Validate checks the field values on RemoveOfferV1Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateOfferV1Request) Validate() error {
+func (m *RemoveOfferV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	if m.GetId() <= 0 {
-		return UpdateOfferV1RequestValidationError{
+		return RemoveOfferV1RequestValidationError{
 			field:  "Id",
 			reason: "value must be greater than 0",
 		}
 	}
 
-	if m.GetUserId() <= 0 {
-		return UpdateOfferV1RequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than 0",
-		}
+	// no validation rules for ExpectedVersion
+
+	return nil
+}
+
+// RemoveOfferV1RequestValidationError is the validation error returned by
+// RemoveOfferV1Request.Validate if the designated constraints aren't met.
+type RemoveOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOfferV1RequestValidationError) ErrorName() string {
+	return "RemoveOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if m.GetGrade() <= 0 {
-		return UpdateOfferV1RequestValidationError{
-			field:  "Grade",
-			reason: "value must be greater than 0",
-		}
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if m.GetTeamId() <= 0 {
-		return UpdateOfferV1RequestValidationError{
-			field:  "TeamId",
+	return fmt.Sprintf(
+		"invalid %sRemoveOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOfferV1RequestValidationError{}
+
+// Validate checks the field values on RemoveOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// RemoveOfferV1ResponseValidationError is the validation error returned by
+// RemoveOfferV1Response.Validate if the designated constraints aren't met.
+type RemoveOfferV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOfferV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOfferV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOfferV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOfferV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOfferV1ResponseValidationError) ErrorName() string {
+	return "RemoveOfferV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOfferV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOfferV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOfferV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOfferV1ResponseValidationError{}
+
+// Validate checks the field values on RestoreOfferV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RestoreOfferV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return RestoreOfferV1RequestValidationError{
+			field:  "Id",
 			reason: "value must be greater than 0",
 		}
 	}
@@ -1092,9 +3736,76 @@ func (m *UpdateOfferV1Request) Validate() error {
 	return nil
 }
 
-// UpdateOfferV1RequestValidationError is the validation error returned by
-// UpdateOfferV1Request.Validate if the designated constraints aren't met.
-type UpdateOfferV1RequestValidationError struct {
+// RestoreOfferV1RequestValidationError is the validation error returned by
+// RestoreOfferV1Request.Validate if the designated constraints aren't met.
+type RestoreOfferV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreOfferV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreOfferV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreOfferV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreOfferV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreOfferV1RequestValidationError) ErrorName() string {
+	return "RestoreOfferV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreOfferV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreOfferV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreOfferV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreOfferV1RequestValidationError{}
+
+// Validate checks the field values on RestoreOfferV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RestoreOfferV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// RestoreOfferV1ResponseValidationError is the validation error returned by
+// RestoreOfferV1Response.Validate if the designated constraints aren't met.
+type RestoreOfferV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1102,24 +3813,24 @@ type UpdateOfferV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateOfferV1RequestValidationError) Field() string { return e.field }
+func (e RestoreOfferV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateOfferV1RequestValidationError) Reason() string { return e.reason }
+func (e RestoreOfferV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateOfferV1RequestValidationError) Cause() error { return e.cause }
+func (e RestoreOfferV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateOfferV1RequestValidationError) Key() bool { return e.key }
+func (e RestoreOfferV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateOfferV1RequestValidationError) ErrorName() string {
-	return "UpdateOfferV1RequestValidationError"
+func (e RestoreOfferV1ResponseValidationError) ErrorName() string {
+	return "RestoreOfferV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateOfferV1RequestValidationError) Error() string {
+func (e RestoreOfferV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1131,14 +3842,14 @@ func (e UpdateOfferV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateOfferV1Request.%s: %s%s",
+		"invalid %sRestoreOfferV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateOfferV1RequestValidationError{}
+var _ error = RestoreOfferV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1146,22 +3857,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateOfferV1RequestValidationError{}
+} = RestoreOfferV1ResponseValidationError{}
 
-// Validate checks the field values on UpdateOfferV1Response with the rules
+// Validate checks the field values on PurgeOffersV1Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateOfferV1Response) Validate() error {
+func (m *PurgeOffersV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	if m.GetDeletedBefore() == nil {
+		return PurgeOffersV1RequestValidationError{
+			field:  "DeletedBefore",
+			reason: "value is required",
+		}
+	}
+
+	if t := m.GetDeletedBefore(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			return PurgeOffersV1RequestValidationError{
+				field:  "DeletedBefore",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+		}
+
+		now := time.Now()
+
+		if ts.Sub(now) >= 0 {
+			return PurgeOffersV1RequestValidationError{
+				field:  "DeletedBefore",
+				reason: "value must be less than now",
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// UpdateOfferV1ResponseValidationError is the validation error returned by
-// UpdateOfferV1Response.Validate if the designated constraints aren't met.
-type UpdateOfferV1ResponseValidationError struct {
+// PurgeOffersV1RequestValidationError is the validation error returned by
+// PurgeOffersV1Request.Validate if the designated constraints aren't met.
+type PurgeOffersV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1169,24 +3908,24 @@ type UpdateOfferV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateOfferV1ResponseValidationError) Field() string { return e.field }
+func (e PurgeOffersV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateOfferV1ResponseValidationError) Reason() string { return e.reason }
+func (e PurgeOffersV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateOfferV1ResponseValidationError) Cause() error { return e.cause }
+func (e PurgeOffersV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateOfferV1ResponseValidationError) Key() bool { return e.key }
+func (e PurgeOffersV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateOfferV1ResponseValidationError) ErrorName() string {
-	return "UpdateOfferV1ResponseValidationError"
+func (e PurgeOffersV1RequestValidationError) ErrorName() string {
+	return "PurgeOffersV1RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateOfferV1ResponseValidationError) Error() string {
+func (e PurgeOffersV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1198,14 +3937,14 @@ func (e UpdateOfferV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateOfferV1Response.%s: %s%s",
+		"invalid %sPurgeOffersV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateOfferV1ResponseValidationError{}
+var _ error = PurgeOffersV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1213,50 +3952,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateOfferV1ResponseValidationError{}
+} = PurgeOffersV1RequestValidationError{}
 
-// Validate checks the field values on TaskUpdateOfferV1Request with the rules
+// Validate checks the field values on PurgeOffersV1Response with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *TaskUpdateOfferV1Request) Validate() error {
+func (m *PurgeOffersV1Response) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetId() <= 0 {
-		return TaskUpdateOfferV1RequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
-		}
-	}
-
-	if m.GetUserId() <= 0 {
-		return TaskUpdateOfferV1RequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than 0",
-		}
-	}
-
-	if m.GetGrade() <= 0 {
-		return TaskUpdateOfferV1RequestValidationError{
-			field:  "Grade",
-			reason: "value must be greater than 0",
-		}
-	}
-
-	if m.GetTeamId() <= 0 {
-		return TaskUpdateOfferV1RequestValidationError{
-			field:  "TeamId",
-			reason: "value must be greater than 0",
-		}
-	}
+	// no validation rules for Count
 
 	return nil
 }
 
-// TaskUpdateOfferV1RequestValidationError is the validation error returned by
-// TaskUpdateOfferV1Request.Validate if the designated constraints aren't met.
-type TaskUpdateOfferV1RequestValidationError struct {
+// PurgeOffersV1ResponseValidationError is the validation error returned by
+// PurgeOffersV1Response.Validate if the designated constraints aren't met.
+type PurgeOffersV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1264,24 +3977,24 @@ type TaskUpdateOfferV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TaskUpdateOfferV1RequestValidationError) Field() string { return e.field }
+func (e PurgeOffersV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TaskUpdateOfferV1RequestValidationError) Reason() string { return e.reason }
+func (e PurgeOffersV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TaskUpdateOfferV1RequestValidationError) Cause() error { return e.cause }
+func (e PurgeOffersV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TaskUpdateOfferV1RequestValidationError) Key() bool { return e.key }
+func (e PurgeOffersV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TaskUpdateOfferV1RequestValidationError) ErrorName() string {
-	return "TaskUpdateOfferV1RequestValidationError"
+func (e PurgeOffersV1ResponseValidationError) ErrorName() string {
+	return "PurgeOffersV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TaskUpdateOfferV1RequestValidationError) Error() string {
+func (e PurgeOffersV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1293,14 +4006,14 @@ func (e TaskUpdateOfferV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTaskUpdateOfferV1Request.%s: %s%s",
+		"invalid %sPurgeOffersV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TaskUpdateOfferV1RequestValidationError{}
+var _ error = PurgeOffersV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1308,22 +4021,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TaskUpdateOfferV1RequestValidationError{}
+} = PurgeOffersV1ResponseValidationError{}
 
-// Validate checks the field values on TaskUpdateOfferV1Response with the rules
+// Validate checks the field values on TaskRemoveOfferV1Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *TaskUpdateOfferV1Response) Validate() error {
+func (m *TaskRemoveOfferV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	if m.GetId() <= 0 {
+		return TaskRemoveOfferV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
 	return nil
 }
 
-// TaskUpdateOfferV1ResponseValidationError is the validation error returned by
-// TaskUpdateOfferV1Response.Validate if the designated constraints aren't met.
-type TaskUpdateOfferV1ResponseValidationError struct {
+// TaskRemoveOfferV1RequestValidationError is the validation error returned by
+// TaskRemoveOfferV1Request.Validate if the designated constraints aren't met.
+type TaskRemoveOfferV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1331,24 +4051,24 @@ type TaskUpdateOfferV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TaskUpdateOfferV1ResponseValidationError) Field() string { return e.field }
+func (e TaskRemoveOfferV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TaskUpdateOfferV1ResponseValidationError) Reason() string { return e.reason }
+func (e TaskRemoveOfferV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TaskUpdateOfferV1ResponseValidationError) Cause() error { return e.cause }
+func (e TaskRemoveOfferV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TaskUpdateOfferV1ResponseValidationError) Key() bool { return e.key }
+func (e TaskRemoveOfferV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TaskUpdateOfferV1ResponseValidationError) ErrorName() string {
-	return "TaskUpdateOfferV1ResponseValidationError"
+func (e TaskRemoveOfferV1RequestValidationError) ErrorName() string {
+	return "TaskRemoveOfferV1RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TaskUpdateOfferV1ResponseValidationError) Error() string {
+func (e TaskRemoveOfferV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1360,14 +4080,14 @@ func (e TaskUpdateOfferV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTaskUpdateOfferV1Response.%s: %s%s",
+		"invalid %sTaskRemoveOfferV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TaskUpdateOfferV1ResponseValidationError{}
+var _ error = TaskRemoveOfferV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1375,29 +4095,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TaskUpdateOfferV1ResponseValidationError{}
+} = TaskRemoveOfferV1RequestValidationError{}
 
-// Validate checks the field values on RemoveOfferV1Request with the rules
+// Validate checks the field values on TaskRemoveOfferV1Response with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *RemoveOfferV1Request) Validate() error {
+func (m *TaskRemoveOfferV1Response) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetId() <= 0 {
-		return RemoveOfferV1RequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
-		}
-	}
+	// no validation rules for TaskId
 
 	return nil
 }
 
-// RemoveOfferV1RequestValidationError is the validation error returned by
-// RemoveOfferV1Request.Validate if the designated constraints aren't met.
-type RemoveOfferV1RequestValidationError struct {
+// TaskRemoveOfferV1ResponseValidationError is the validation error returned by
+// TaskRemoveOfferV1Response.Validate if the designated constraints aren't met.
+type TaskRemoveOfferV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1405,24 +4120,24 @@ type RemoveOfferV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveOfferV1RequestValidationError) Field() string { return e.field }
+func (e TaskRemoveOfferV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveOfferV1RequestValidationError) Reason() string { return e.reason }
+func (e TaskRemoveOfferV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveOfferV1RequestValidationError) Cause() error { return e.cause }
+func (e TaskRemoveOfferV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveOfferV1RequestValidationError) Key() bool { return e.key }
+func (e TaskRemoveOfferV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOfferV1RequestValidationError) ErrorName() string {
-	return "RemoveOfferV1RequestValidationError"
+func (e TaskRemoveOfferV1ResponseValidationError) ErrorName() string {
+	return "TaskRemoveOfferV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveOfferV1RequestValidationError) Error() string {
+func (e TaskRemoveOfferV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1434,14 +4149,14 @@ func (e RemoveOfferV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOfferV1Request.%s: %s%s",
+		"invalid %sTaskRemoveOfferV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOfferV1RequestValidationError{}
+var _ error = TaskRemoveOfferV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1449,22 +4164,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOfferV1RequestValidationError{}
+} = TaskRemoveOfferV1ResponseValidationError{}
 
-// Validate checks the field values on RemoveOfferV1Response with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *RemoveOfferV1Response) Validate() error {
+// Validate checks the field values on Task with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Task) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Parts
+
+	// no validation rules for PartsDone
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
-// RemoveOfferV1ResponseValidationError is the validation error returned by
-// RemoveOfferV1Response.Validate if the designated constraints aren't met.
-type RemoveOfferV1ResponseValidationError struct {
+// TaskValidationError is the validation error returned by Task.Validate if the
+// designated constraints aren't met.
+type TaskValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1472,24 +4218,22 @@ type RemoveOfferV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveOfferV1ResponseValidationError) Field() string { return e.field }
+func (e TaskValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveOfferV1ResponseValidationError) Reason() string { return e.reason }
+func (e TaskValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveOfferV1ResponseValidationError) Cause() error { return e.cause }
+func (e TaskValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveOfferV1ResponseValidationError) Key() bool { return e.key }
+func (e TaskValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOfferV1ResponseValidationError) ErrorName() string {
-	return "RemoveOfferV1ResponseValidationError"
-}
+func (e TaskValidationError) ErrorName() string { return "TaskValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RemoveOfferV1ResponseValidationError) Error() string {
+func (e TaskValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1501,14 +4245,14 @@ func (e RemoveOfferV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOfferV1Response.%s: %s%s",
+		"invalid %sTask.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOfferV1ResponseValidationError{}
+var _ error = TaskValidationError{}
 
 var _ interface {
 	Field() string
@@ -1516,29 +4260,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOfferV1ResponseValidationError{}
+} = TaskValidationError{}
 
-// Validate checks the field values on TaskRemoveOfferV1Request with the rules
+// Validate checks the field values on GetTaskStatusV1Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *TaskRemoveOfferV1Request) Validate() error {
+func (m *GetTaskStatusV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetId() <= 0 {
-		return TaskRemoveOfferV1RequestValidationError{
+	if err := m._validateUuid(m.GetId()); err != nil {
+		return GetTaskStatusV1RequestValidationError{
 			field:  "Id",
-			reason: "value must be greater than 0",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
 	}
 
 	return nil
 }
 
-// TaskRemoveOfferV1RequestValidationError is the validation error returned by
-// TaskRemoveOfferV1Request.Validate if the designated constraints aren't met.
-type TaskRemoveOfferV1RequestValidationError struct {
+func (m *GetTaskStatusV1Request) _validateUuid(uuid string) error {
+	if matched := _ocp_offer_api_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetTaskStatusV1RequestValidationError is the validation error returned by
+// GetTaskStatusV1Request.Validate if the designated constraints aren't met.
+type GetTaskStatusV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1546,24 +4299,24 @@ type TaskRemoveOfferV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TaskRemoveOfferV1RequestValidationError) Field() string { return e.field }
+func (e GetTaskStatusV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TaskRemoveOfferV1RequestValidationError) Reason() string { return e.reason }
+func (e GetTaskStatusV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TaskRemoveOfferV1RequestValidationError) Cause() error { return e.cause }
+func (e GetTaskStatusV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TaskRemoveOfferV1RequestValidationError) Key() bool { return e.key }
+func (e GetTaskStatusV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TaskRemoveOfferV1RequestValidationError) ErrorName() string {
-	return "TaskRemoveOfferV1RequestValidationError"
+func (e GetTaskStatusV1RequestValidationError) ErrorName() string {
+	return "GetTaskStatusV1RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TaskRemoveOfferV1RequestValidationError) Error() string {
+func (e GetTaskStatusV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1575,14 +4328,14 @@ func (e TaskRemoveOfferV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTaskRemoveOfferV1Request.%s: %s%s",
+		"invalid %sGetTaskStatusV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TaskRemoveOfferV1RequestValidationError{}
+var _ error = GetTaskStatusV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1590,22 +4343,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TaskRemoveOfferV1RequestValidationError{}
+} = GetTaskStatusV1RequestValidationError{}
 
-// Validate checks the field values on TaskRemoveOfferV1Response with the rules
+// Validate checks the field values on GetTaskStatusV1Response with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *TaskRemoveOfferV1Response) Validate() error {
+func (m *GetTaskStatusV1Response) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTaskStatusV1ResponseValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
-// TaskRemoveOfferV1ResponseValidationError is the validation error returned by
-// TaskRemoveOfferV1Response.Validate if the designated constraints aren't met.
-type TaskRemoveOfferV1ResponseValidationError struct {
+// GetTaskStatusV1ResponseValidationError is the validation error returned by
+// GetTaskStatusV1Response.Validate if the designated constraints aren't met.
+type GetTaskStatusV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1613,24 +4376,24 @@ type TaskRemoveOfferV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TaskRemoveOfferV1ResponseValidationError) Field() string { return e.field }
+func (e GetTaskStatusV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TaskRemoveOfferV1ResponseValidationError) Reason() string { return e.reason }
+func (e GetTaskStatusV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TaskRemoveOfferV1ResponseValidationError) Cause() error { return e.cause }
+func (e GetTaskStatusV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TaskRemoveOfferV1ResponseValidationError) Key() bool { return e.key }
+func (e GetTaskStatusV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TaskRemoveOfferV1ResponseValidationError) ErrorName() string {
-	return "TaskRemoveOfferV1ResponseValidationError"
+func (e GetTaskStatusV1ResponseValidationError) ErrorName() string {
+	return "GetTaskStatusV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TaskRemoveOfferV1ResponseValidationError) Error() string {
+func (e GetTaskStatusV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1642,14 +4405,14 @@ func (e TaskRemoveOfferV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTaskRemoveOfferV1Response.%s: %s%s",
+		"invalid %sGetTaskStatusV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TaskRemoveOfferV1ResponseValidationError{}
+var _ error = GetTaskStatusV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1657,7 +4420,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TaskRemoveOfferV1ResponseValidationError{}
+} = GetTaskStatusV1ResponseValidationError{}
 
 // Validate checks the field values on PaginationInfo with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
@@ -1679,6 +4442,8 @@ func (m *PaginationInfo) Validate() error {
 
 	// no validation rules for HasPreviousPage
 
+	// no validation rules for NextPageToken
+
 	return nil
 }
 
@@ -1760,6 +4525,15 @@ func (m *PaginationInput) Validate() error {
 		}
 	}
 
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		return PaginationInputValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+	}
+
+	// no validation rules for SkipCount
+
 	return nil
 }
 
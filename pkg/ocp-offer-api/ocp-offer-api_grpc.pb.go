@@ -18,7 +18,8 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OcpOfferApiServiceClient interface {
-	// CreateOfferV1 - Create an offer
+	// CreateOfferV1 - Create an offer.
+	// Only one live offer per user and team may exist, removed offers don't count
 	CreateOfferV1(ctx context.Context, in *CreateOfferV1Request, opts ...grpc.CallOption) (*CreateOfferV1Response, error)
 	// TaskCreateOfferV1 - Create an offer
 	TaskCreateOfferV1(ctx context.Context, in *TaskCreateOfferV1Request, opts ...grpc.CallOption) (*TaskCreateOfferV1Response, error)
@@ -28,16 +29,46 @@ type OcpOfferApiServiceClient interface {
 	TaskMultiCreateOfferV1(ctx context.Context, in *TaskMultiCreateOfferV1Request, opts ...grpc.CallOption) (*TaskMultiCreateOfferV1Response, error)
 	// DescribeOfferV1 - Get information about the offer
 	DescribeOfferV1(ctx context.Context, in *DescribeOfferV1Request, opts ...grpc.CallOption) (*DescribeOfferV1Response, error)
+	// BatchGetOffersV1 - Gets offers by ids, missing offers don't fail the call
+	BatchGetOffersV1(ctx context.Context, in *BatchGetOffersV1Request, opts ...grpc.CallOption) (*BatchGetOffersV1Response, error)
+	// ExportOffersV1 - Streams all offers matching the filter ordered by id.
+	// The gateway returns newline-delimited JSON, CSV is available at
+	// `/v1/offers:export.csv` with the same query parameters
+	ExportOffersV1(ctx context.Context, in *ExportOffersV1Request, opts ...grpc.CallOption) (OcpOfferApiService_ExportOffersV1Client, error)
+	// WatchOffersV1 - Streams changes of the offers as they happen. Without
+	// `resume_token` only the changes after the call are streamed
+	WatchOffersV1(ctx context.Context, in *WatchOffersV1Request, opts ...grpc.CallOption) (OcpOfferApiService_WatchOffersV1Client, error)
+	// GetOfferStatsV1 - Counts offers grouped by the requested dimensions
+	GetOfferStatsV1(ctx context.Context, in *GetOfferStatsV1Request, opts ...grpc.CallOption) (*GetOfferStatsV1Response, error)
+	// GetOfferHistoryV1 - Gets all revisions of the offer
+	GetOfferHistoryV1(ctx context.Context, in *GetOfferHistoryV1Request, opts ...grpc.CallOption) (*GetOfferHistoryV1Response, error)
 	// ListOfferV1 - Gets a list of offers
 	ListOfferV1(ctx context.Context, in *ListOfferV1Request, opts ...grpc.CallOption) (*ListOfferV1Response, error)
 	// UpdateOfferV1 - Updates the offer
 	UpdateOfferV1(ctx context.Context, in *UpdateOfferV1Request, opts ...grpc.CallOption) (*UpdateOfferV1Response, error)
+	// PatchOfferV1 - Updates the offer fields listed in `update_mask`
+	PatchOfferV1(ctx context.Context, in *PatchOfferV1Request, opts ...grpc.CallOption) (*PatchOfferV1Response, error)
+	// SendOfferV1 - Sends a draft offer to the student
+	SendOfferV1(ctx context.Context, in *SendOfferV1Request, opts ...grpc.CallOption) (*SendOfferV1Response, error)
+	// AcceptOfferV1 - Marks the sent offer as accepted
+	AcceptOfferV1(ctx context.Context, in *AcceptOfferV1Request, opts ...grpc.CallOption) (*AcceptOfferV1Response, error)
+	// DeclineOfferV1 - Marks the sent offer as declined
+	DeclineOfferV1(ctx context.Context, in *DeclineOfferV1Request, opts ...grpc.CallOption) (*DeclineOfferV1Response, error)
+	// WithdrawOfferV1 - Withdraws a draft or sent offer
+	WithdrawOfferV1(ctx context.Context, in *WithdrawOfferV1Request, opts ...grpc.CallOption) (*WithdrawOfferV1Response, error)
 	// TaskUpdateOfferV1 - Updates the offer
 	TaskUpdateOfferV1(ctx context.Context, in *TaskUpdateOfferV1Request, opts ...grpc.CallOption) (*TaskUpdateOfferV1Response, error)
 	// RemoveOfferV1 - Removes offer
 	RemoveOfferV1(ctx context.Context, in *RemoveOfferV1Request, opts ...grpc.CallOption) (*RemoveOfferV1Response, error)
+	// RestoreOfferV1 - Restores a removed offer.
+	// Fails with ALREADY_EXISTS if the user got a new offer in the same team
+	RestoreOfferV1(ctx context.Context, in *RestoreOfferV1Request, opts ...grpc.CallOption) (*RestoreOfferV1Response, error)
+	// PurgeOffersV1 - Permanently deletes offers removed before the given moment
+	PurgeOffersV1(ctx context.Context, in *PurgeOffersV1Request, opts ...grpc.CallOption) (*PurgeOffersV1Response, error)
 	// TaskRemoveOfferV1 - Removes offer
 	TaskRemoveOfferV1(ctx context.Context, in *TaskRemoveOfferV1Request, opts ...grpc.CallOption) (*TaskRemoveOfferV1Response, error)
+	// GetTaskStatusV1 - Gets the state of a task started by a Task* method
+	GetTaskStatusV1(ctx context.Context, in *GetTaskStatusV1Request, opts ...grpc.CallOption) (*GetTaskStatusV1Response, error)
 }
 
 type ocpOfferApiServiceClient struct {
@@ -93,6 +124,97 @@ func (c *ocpOfferApiServiceClient) DescribeOfferV1(ctx context.Context, in *Desc
 	return out, nil
 }
 
+func (c *ocpOfferApiServiceClient) BatchGetOffersV1(ctx context.Context, in *BatchGetOffersV1Request, opts ...grpc.CallOption) (*BatchGetOffersV1Response, error) {
+	out := new(BatchGetOffersV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/BatchGetOffersV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpOfferApiServiceClient) ExportOffersV1(ctx context.Context, in *ExportOffersV1Request, opts ...grpc.CallOption) (OcpOfferApiService_ExportOffersV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &OcpOfferApiService_ServiceDesc.Streams[0], "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/ExportOffersV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ocpOfferApiServiceExportOffersV1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OcpOfferApiService_ExportOffersV1Client interface {
+	Recv() (*ExportOffersV1Response, error)
+	grpc.ClientStream
+}
+
+type ocpOfferApiServiceExportOffersV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *ocpOfferApiServiceExportOffersV1Client) Recv() (*ExportOffersV1Response, error) {
+	m := new(ExportOffersV1Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ocpOfferApiServiceClient) WatchOffersV1(ctx context.Context, in *WatchOffersV1Request, opts ...grpc.CallOption) (OcpOfferApiService_WatchOffersV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &OcpOfferApiService_ServiceDesc.Streams[1], "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/WatchOffersV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ocpOfferApiServiceWatchOffersV1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OcpOfferApiService_WatchOffersV1Client interface {
+	Recv() (*WatchOffersV1Response, error)
+	grpc.ClientStream
+}
+
+type ocpOfferApiServiceWatchOffersV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *ocpOfferApiServiceWatchOffersV1Client) Recv() (*WatchOffersV1Response, error) {
+	m := new(WatchOffersV1Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ocpOfferApiServiceClient) GetOfferStatsV1(ctx context.Context, in *GetOfferStatsV1Request, opts ...grpc.CallOption) (*GetOfferStatsV1Response, error) {
+	out := new(GetOfferStatsV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/GetOfferStatsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpOfferApiServiceClient) GetOfferHistoryV1(ctx context.Context, in *GetOfferHistoryV1Request, opts ...grpc.CallOption) (*GetOfferHistoryV1Response, error) {
+	out := new(GetOfferHistoryV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/GetOfferHistoryV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ocpOfferApiServiceClient) ListOfferV1(ctx context.Context, in *ListOfferV1Request, opts ...grpc.CallOption) (*ListOfferV1Response, error) {
 	out := new(ListOfferV1Response)
 	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/ListOfferV1", in, out, opts...)
@@ -111,6 +233,51 @@ func (c *ocpOfferApiServiceClient) UpdateOfferV1(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *ocpOfferApiServiceClient) PatchOfferV1(ctx context.Context, in *PatchOfferV1Request, opts ...grpc.CallOption) (*PatchOfferV1Response, error) {
+	out := new(PatchOfferV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/PatchOfferV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpOfferApiServiceClient) SendOfferV1(ctx context.Context, in *SendOfferV1Request, opts ...grpc.CallOption) (*SendOfferV1Response, error) {
+	out := new(SendOfferV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/SendOfferV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpOfferApiServiceClient) AcceptOfferV1(ctx context.Context, in *AcceptOfferV1Request, opts ...grpc.CallOption) (*AcceptOfferV1Response, error) {
+	out := new(AcceptOfferV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/AcceptOfferV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpOfferApiServiceClient) DeclineOfferV1(ctx context.Context, in *DeclineOfferV1Request, opts ...grpc.CallOption) (*DeclineOfferV1Response, error) {
+	out := new(DeclineOfferV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/DeclineOfferV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpOfferApiServiceClient) WithdrawOfferV1(ctx context.Context, in *WithdrawOfferV1Request, opts ...grpc.CallOption) (*WithdrawOfferV1Response, error) {
+	out := new(WithdrawOfferV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/WithdrawOfferV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ocpOfferApiServiceClient) TaskUpdateOfferV1(ctx context.Context, in *TaskUpdateOfferV1Request, opts ...grpc.CallOption) (*TaskUpdateOfferV1Response, error) {
 	out := new(TaskUpdateOfferV1Response)
 	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/TaskUpdateOfferV1", in, out, opts...)
@@ -129,6 +296,24 @@ func (c *ocpOfferApiServiceClient) RemoveOfferV1(ctx context.Context, in *Remove
 	return out, nil
 }
 
+func (c *ocpOfferApiServiceClient) RestoreOfferV1(ctx context.Context, in *RestoreOfferV1Request, opts ...grpc.CallOption) (*RestoreOfferV1Response, error) {
+	out := new(RestoreOfferV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/RestoreOfferV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpOfferApiServiceClient) PurgeOffersV1(ctx context.Context, in *PurgeOffersV1Request, opts ...grpc.CallOption) (*PurgeOffersV1Response, error) {
+	out := new(PurgeOffersV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/PurgeOffersV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ocpOfferApiServiceClient) TaskRemoveOfferV1(ctx context.Context, in *TaskRemoveOfferV1Request, opts ...grpc.CallOption) (*TaskRemoveOfferV1Response, error) {
 	out := new(TaskRemoveOfferV1Response)
 	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/TaskRemoveOfferV1", in, out, opts...)
@@ -138,11 +323,21 @@ func (c *ocpOfferApiServiceClient) TaskRemoveOfferV1(ctx context.Context, in *Ta
 	return out, nil
 }
 
+func (c *ocpOfferApiServiceClient) GetTaskStatusV1(ctx context.Context, in *GetTaskStatusV1Request, opts ...grpc.CallOption) (*GetTaskStatusV1Response, error) {
+	out := new(GetTaskStatusV1Response)
+	err := c.cc.Invoke(ctx, "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/GetTaskStatusV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OcpOfferApiServiceServer is the server API for OcpOfferApiService service.
 // All implementations must embed UnimplementedOcpOfferApiServiceServer
 // for forward compatibility
 type OcpOfferApiServiceServer interface {
-	// CreateOfferV1 - Create an offer
+	// CreateOfferV1 - Create an offer.
+	// Only one live offer per user and team may exist, removed offers don't count
 	CreateOfferV1(context.Context, *CreateOfferV1Request) (*CreateOfferV1Response, error)
 	// TaskCreateOfferV1 - Create an offer
 	TaskCreateOfferV1(context.Context, *TaskCreateOfferV1Request) (*TaskCreateOfferV1Response, error)
@@ -152,16 +347,46 @@ type OcpOfferApiServiceServer interface {
 	TaskMultiCreateOfferV1(context.Context, *TaskMultiCreateOfferV1Request) (*TaskMultiCreateOfferV1Response, error)
 	// DescribeOfferV1 - Get information about the offer
 	DescribeOfferV1(context.Context, *DescribeOfferV1Request) (*DescribeOfferV1Response, error)
+	// BatchGetOffersV1 - Gets offers by ids, missing offers don't fail the call
+	BatchGetOffersV1(context.Context, *BatchGetOffersV1Request) (*BatchGetOffersV1Response, error)
+	// ExportOffersV1 - Streams all offers matching the filter ordered by id.
+	// The gateway returns newline-delimited JSON, CSV is available at
+	// `/v1/offers:export.csv` with the same query parameters
+	ExportOffersV1(*ExportOffersV1Request, OcpOfferApiService_ExportOffersV1Server) error
+	// WatchOffersV1 - Streams changes of the offers as they happen. Without
+	// `resume_token` only the changes after the call are streamed
+	WatchOffersV1(*WatchOffersV1Request, OcpOfferApiService_WatchOffersV1Server) error
+	// GetOfferStatsV1 - Counts offers grouped by the requested dimensions
+	GetOfferStatsV1(context.Context, *GetOfferStatsV1Request) (*GetOfferStatsV1Response, error)
+	// GetOfferHistoryV1 - Gets all revisions of the offer
+	GetOfferHistoryV1(context.Context, *GetOfferHistoryV1Request) (*GetOfferHistoryV1Response, error)
 	// ListOfferV1 - Gets a list of offers
 	ListOfferV1(context.Context, *ListOfferV1Request) (*ListOfferV1Response, error)
 	// UpdateOfferV1 - Updates the offer
 	UpdateOfferV1(context.Context, *UpdateOfferV1Request) (*UpdateOfferV1Response, error)
+	// PatchOfferV1 - Updates the offer fields listed in `update_mask`
+	PatchOfferV1(context.Context, *PatchOfferV1Request) (*PatchOfferV1Response, error)
+	// SendOfferV1 - Sends a draft offer to the student
+	SendOfferV1(context.Context, *SendOfferV1Request) (*SendOfferV1Response, error)
+	// AcceptOfferV1 - Marks the sent offer as accepted
+	AcceptOfferV1(context.Context, *AcceptOfferV1Request) (*AcceptOfferV1Response, error)
+	// DeclineOfferV1 - Marks the sent offer as declined
+	DeclineOfferV1(context.Context, *DeclineOfferV1Request) (*DeclineOfferV1Response, error)
+	// WithdrawOfferV1 - Withdraws a draft or sent offer
+	WithdrawOfferV1(context.Context, *WithdrawOfferV1Request) (*WithdrawOfferV1Response, error)
 	// TaskUpdateOfferV1 - Updates the offer
 	TaskUpdateOfferV1(context.Context, *TaskUpdateOfferV1Request) (*TaskUpdateOfferV1Response, error)
 	// RemoveOfferV1 - Removes offer
 	RemoveOfferV1(context.Context, *RemoveOfferV1Request) (*RemoveOfferV1Response, error)
+	// RestoreOfferV1 - Restores a removed offer.
+	// Fails with ALREADY_EXISTS if the user got a new offer in the same team
+	RestoreOfferV1(context.Context, *RestoreOfferV1Request) (*RestoreOfferV1Response, error)
+	// PurgeOffersV1 - Permanently deletes offers removed before the given moment
+	PurgeOffersV1(context.Context, *PurgeOffersV1Request) (*PurgeOffersV1Response, error)
 	// TaskRemoveOfferV1 - Removes offer
 	TaskRemoveOfferV1(context.Context, *TaskRemoveOfferV1Request) (*TaskRemoveOfferV1Response, error)
+	// GetTaskStatusV1 - Gets the state of a task started by a Task* method
+	GetTaskStatusV1(context.Context, *GetTaskStatusV1Request) (*GetTaskStatusV1Response, error)
 	mustEmbedUnimplementedOcpOfferApiServiceServer()
 }
 
@@ -184,21 +409,60 @@ func (UnimplementedOcpOfferApiServiceServer) TaskMultiCreateOfferV1(context.Cont
 func (UnimplementedOcpOfferApiServiceServer) DescribeOfferV1(context.Context, *DescribeOfferV1Request) (*DescribeOfferV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeOfferV1 not implemented")
 }
+func (UnimplementedOcpOfferApiServiceServer) BatchGetOffersV1(context.Context, *BatchGetOffersV1Request) (*BatchGetOffersV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetOffersV1 not implemented")
+}
+func (UnimplementedOcpOfferApiServiceServer) ExportOffersV1(*ExportOffersV1Request, OcpOfferApiService_ExportOffersV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOffersV1 not implemented")
+}
+func (UnimplementedOcpOfferApiServiceServer) WatchOffersV1(*WatchOffersV1Request, OcpOfferApiService_WatchOffersV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOffersV1 not implemented")
+}
+func (UnimplementedOcpOfferApiServiceServer) GetOfferStatsV1(context.Context, *GetOfferStatsV1Request) (*GetOfferStatsV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOfferStatsV1 not implemented")
+}
+func (UnimplementedOcpOfferApiServiceServer) GetOfferHistoryV1(context.Context, *GetOfferHistoryV1Request) (*GetOfferHistoryV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOfferHistoryV1 not implemented")
+}
 func (UnimplementedOcpOfferApiServiceServer) ListOfferV1(context.Context, *ListOfferV1Request) (*ListOfferV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOfferV1 not implemented")
 }
 func (UnimplementedOcpOfferApiServiceServer) UpdateOfferV1(context.Context, *UpdateOfferV1Request) (*UpdateOfferV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOfferV1 not implemented")
 }
+func (UnimplementedOcpOfferApiServiceServer) PatchOfferV1(context.Context, *PatchOfferV1Request) (*PatchOfferV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchOfferV1 not implemented")
+}
+func (UnimplementedOcpOfferApiServiceServer) SendOfferV1(context.Context, *SendOfferV1Request) (*SendOfferV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendOfferV1 not implemented")
+}
+func (UnimplementedOcpOfferApiServiceServer) AcceptOfferV1(context.Context, *AcceptOfferV1Request) (*AcceptOfferV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOfferV1 not implemented")
+}
+func (UnimplementedOcpOfferApiServiceServer) DeclineOfferV1(context.Context, *DeclineOfferV1Request) (*DeclineOfferV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineOfferV1 not implemented")
+}
+func (UnimplementedOcpOfferApiServiceServer) WithdrawOfferV1(context.Context, *WithdrawOfferV1Request) (*WithdrawOfferV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawOfferV1 not implemented")
+}
 func (UnimplementedOcpOfferApiServiceServer) TaskUpdateOfferV1(context.Context, *TaskUpdateOfferV1Request) (*TaskUpdateOfferV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskUpdateOfferV1 not implemented")
 }
 func (UnimplementedOcpOfferApiServiceServer) RemoveOfferV1(context.Context, *RemoveOfferV1Request) (*RemoveOfferV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveOfferV1 not implemented")
 }
+func (UnimplementedOcpOfferApiServiceServer) RestoreOfferV1(context.Context, *RestoreOfferV1Request) (*RestoreOfferV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreOfferV1 not implemented")
+}
+func (UnimplementedOcpOfferApiServiceServer) PurgeOffersV1(context.Context, *PurgeOffersV1Request) (*PurgeOffersV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeOffersV1 not implemented")
+}
 func (UnimplementedOcpOfferApiServiceServer) TaskRemoveOfferV1(context.Context, *TaskRemoveOfferV1Request) (*TaskRemoveOfferV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskRemoveOfferV1 not implemented")
 }
+func (UnimplementedOcpOfferApiServiceServer) GetTaskStatusV1(context.Context, *GetTaskStatusV1Request) (*GetTaskStatusV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskStatusV1 not implemented")
+}
 func (UnimplementedOcpOfferApiServiceServer) mustEmbedUnimplementedOcpOfferApiServiceServer() {}
 
 // UnsafeOcpOfferApiServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -302,6 +566,102 @@ func _OcpOfferApiService_DescribeOfferV1_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpOfferApiService_BatchGetOffersV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetOffersV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).BatchGetOffersV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/BatchGetOffersV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).BatchGetOffersV1(ctx, req.(*BatchGetOffersV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpOfferApiService_ExportOffersV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOffersV1Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OcpOfferApiServiceServer).ExportOffersV1(m, &ocpOfferApiServiceExportOffersV1Server{stream})
+}
+
+type OcpOfferApiService_ExportOffersV1Server interface {
+	Send(*ExportOffersV1Response) error
+	grpc.ServerStream
+}
+
+type ocpOfferApiServiceExportOffersV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *ocpOfferApiServiceExportOffersV1Server) Send(m *ExportOffersV1Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OcpOfferApiService_WatchOffersV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOffersV1Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OcpOfferApiServiceServer).WatchOffersV1(m, &ocpOfferApiServiceWatchOffersV1Server{stream})
+}
+
+type OcpOfferApiService_WatchOffersV1Server interface {
+	Send(*WatchOffersV1Response) error
+	grpc.ServerStream
+}
+
+type ocpOfferApiServiceWatchOffersV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *ocpOfferApiServiceWatchOffersV1Server) Send(m *WatchOffersV1Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OcpOfferApiService_GetOfferStatsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOfferStatsV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).GetOfferStatsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/GetOfferStatsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).GetOfferStatsV1(ctx, req.(*GetOfferStatsV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpOfferApiService_GetOfferHistoryV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOfferHistoryV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).GetOfferHistoryV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/GetOfferHistoryV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).GetOfferHistoryV1(ctx, req.(*GetOfferHistoryV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OcpOfferApiService_ListOfferV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOfferV1Request)
 	if err := dec(in); err != nil {
@@ -338,6 +698,96 @@ func _OcpOfferApiService_UpdateOfferV1_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpOfferApiService_PatchOfferV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchOfferV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).PatchOfferV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/PatchOfferV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).PatchOfferV1(ctx, req.(*PatchOfferV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpOfferApiService_SendOfferV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendOfferV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).SendOfferV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/SendOfferV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).SendOfferV1(ctx, req.(*SendOfferV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpOfferApiService_AcceptOfferV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOfferV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).AcceptOfferV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/AcceptOfferV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).AcceptOfferV1(ctx, req.(*AcceptOfferV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpOfferApiService_DeclineOfferV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineOfferV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).DeclineOfferV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/DeclineOfferV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).DeclineOfferV1(ctx, req.(*DeclineOfferV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpOfferApiService_WithdrawOfferV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawOfferV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).WithdrawOfferV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/WithdrawOfferV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).WithdrawOfferV1(ctx, req.(*WithdrawOfferV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OcpOfferApiService_TaskUpdateOfferV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TaskUpdateOfferV1Request)
 	if err := dec(in); err != nil {
@@ -374,6 +824,42 @@ func _OcpOfferApiService_RemoveOfferV1_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpOfferApiService_RestoreOfferV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOfferV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).RestoreOfferV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/RestoreOfferV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).RestoreOfferV1(ctx, req.(*RestoreOfferV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpOfferApiService_PurgeOffersV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeOffersV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).PurgeOffersV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/PurgeOffersV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).PurgeOffersV1(ctx, req.(*PurgeOffersV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OcpOfferApiService_TaskRemoveOfferV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TaskRemoveOfferV1Request)
 	if err := dec(in); err != nil {
@@ -392,6 +878,24 @@ func _OcpOfferApiService_TaskRemoveOfferV1_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpOfferApiService_GetTaskStatusV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskStatusV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpOfferApiServiceServer).GetTaskStatusV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozoncp.ocp_offer_api.v1.OcpOfferApiService/GetTaskStatusV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpOfferApiServiceServer).GetTaskStatusV1(ctx, req.(*GetTaskStatusV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OcpOfferApiService_ServiceDesc is the grpc.ServiceDesc for OcpOfferApiService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +923,18 @@ var OcpOfferApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeOfferV1",
 			Handler:    _OcpOfferApiService_DescribeOfferV1_Handler,
 		},
+		{
+			MethodName: "BatchGetOffersV1",
+			Handler:    _OcpOfferApiService_BatchGetOffersV1_Handler,
+		},
+		{
+			MethodName: "GetOfferStatsV1",
+			Handler:    _OcpOfferApiService_GetOfferStatsV1_Handler,
+		},
+		{
+			MethodName: "GetOfferHistoryV1",
+			Handler:    _OcpOfferApiService_GetOfferHistoryV1_Handler,
+		},
 		{
 			MethodName: "ListOfferV1",
 			Handler:    _OcpOfferApiService_ListOfferV1_Handler,
@@ -427,6 +943,26 @@ var OcpOfferApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOfferV1",
 			Handler:    _OcpOfferApiService_UpdateOfferV1_Handler,
 		},
+		{
+			MethodName: "PatchOfferV1",
+			Handler:    _OcpOfferApiService_PatchOfferV1_Handler,
+		},
+		{
+			MethodName: "SendOfferV1",
+			Handler:    _OcpOfferApiService_SendOfferV1_Handler,
+		},
+		{
+			MethodName: "AcceptOfferV1",
+			Handler:    _OcpOfferApiService_AcceptOfferV1_Handler,
+		},
+		{
+			MethodName: "DeclineOfferV1",
+			Handler:    _OcpOfferApiService_DeclineOfferV1_Handler,
+		},
+		{
+			MethodName: "WithdrawOfferV1",
+			Handler:    _OcpOfferApiService_WithdrawOfferV1_Handler,
+		},
 		{
 			MethodName: "TaskUpdateOfferV1",
 			Handler:    _OcpOfferApiService_TaskUpdateOfferV1_Handler,
@@ -435,11 +971,34 @@ var OcpOfferApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveOfferV1",
 			Handler:    _OcpOfferApiService_RemoveOfferV1_Handler,
 		},
+		{
+			MethodName: "RestoreOfferV1",
+			Handler:    _OcpOfferApiService_RestoreOfferV1_Handler,
+		},
+		{
+			MethodName: "PurgeOffersV1",
+			Handler:    _OcpOfferApiService_PurgeOffersV1_Handler,
+		},
 		{
 			MethodName: "TaskRemoveOfferV1",
 			Handler:    _OcpOfferApiService_TaskRemoveOfferV1_Handler,
 		},
+		{
+			MethodName: "GetTaskStatusV1",
+			Handler:    _OcpOfferApiService_GetTaskStatusV1_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportOffersV1",
+			Handler:       _OcpOfferApiService_ExportOffersV1_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOffersV1",
+			Handler:       _OcpOfferApiService_WatchOffersV1_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ozoncp/ocp-offer-api/v1/ocp-offer-api.proto",
 }